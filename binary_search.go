@@ -0,0 +1,126 @@
+package gollections
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Returns the index of the first occurrence of target in the sorted slice, -1 if it is not there
+func BinarySearch[T constraints.Ordered] (slice []T, target T) int {
+    return searchIndex(len(slice), func(i int) int { return compareOrdered(slice[i], target) })
+}
+
+// Returns the index of the first element whose key equals target, -1 if there is none.
+// The slice must be sorted by the key in ascending order.
+func BinarySearchBy[T any, K constraints.Ordered] (slice []T, key func(T) K, target K) int {
+    if key == nil { return -1 }
+    return searchIndex(len(slice), func(i int) int { return compareOrdered(key(slice[i]), target) })
+}
+
+// Returns the index of the first element equal to target according to the comparer, -1 if there is none.
+// The slice must be sorted by the comparer. If a > b then comparer(a, b) > 0
+func BinarySearchWith[T any] (slice []T, target T, comparer func(T, T) int) int {
+    if comparer == nil { return -1 }
+    return searchIndex(len(slice), func(i int) int { return comparer(slice[i], target) })
+}
+
+// Returns the index of the first element not less than target in the sorted slice.
+// This is where target would be inserted before any equal elements, len(slice) if all are less.
+func LowerBound[T constraints.Ordered] (slice []T, target T) int {
+    return lowerBound(len(slice), func(i int) int { return compareOrdered(slice[i], target) })
+}
+
+// Returns the index of the first element whose key is not less than target.
+// The slice must be sorted by the key in ascending order. Returns -1 if key is nil.
+func LowerBoundBy[T any, K constraints.Ordered] (slice []T, key func(T) K, target K) int {
+    if key == nil { return -1 }
+    return lowerBound(len(slice), func(i int) int { return compareOrdered(key(slice[i]), target) })
+}
+
+// Returns the index of the first element not less than target according to the comparer.
+// The slice must be sorted by the comparer. Returns -1 if comparer is nil.
+func LowerBoundWith[T any] (slice []T, target T, comparer func(T, T) int) int {
+    if comparer == nil { return -1 }
+    return lowerBound(len(slice), func(i int) int { return comparer(slice[i], target) })
+}
+
+// Returns the index of the first element greater than target in the sorted slice.
+// This is where target would be inserted after any equal elements, len(slice) if none is greater.
+func UpperBound[T constraints.Ordered] (slice []T, target T) int {
+    return upperBound(len(slice), func(i int) int { return compareOrdered(slice[i], target) })
+}
+
+// Returns the index of the first element whose key is greater than target.
+// The slice must be sorted by the key in ascending order. Returns -1 if key is nil.
+func UpperBoundBy[T any, K constraints.Ordered] (slice []T, key func(T) K, target K) int {
+    if key == nil { return -1 }
+    return upperBound(len(slice), func(i int) int { return compareOrdered(key(slice[i]), target) })
+}
+
+// Returns the index of the first element greater than target according to the comparer.
+// The slice must be sorted by the comparer. Returns -1 if comparer is nil.
+func UpperBoundWith[T any] (slice []T, target T, comparer func(T, T) int) int {
+    if comparer == nil { return -1 }
+    return upperBound(len(slice), func(i int) int { return comparer(slice[i], target) })
+}
+
+// Returns the half open range [from, to) of elements equal to target in the sorted slice.
+// The range is empty, with from == to at the insertion point, if target is not there.
+func EqualRange[T constraints.Ordered] (slice []T, target T) (int, int) {
+    return LowerBound(slice, target), UpperBound(slice, target)
+}
+
+// Returns the half open range [from, to) of elements whose key equals target.
+// The slice must be sorted by the key in ascending order. Returns -1, -1 if key is nil.
+func EqualRangeBy[T any, K constraints.Ordered] (slice []T, key func(T) K, target K) (int, int) {
+    return LowerBoundBy(slice, key, target), UpperBoundBy(slice, key, target)
+}
+
+// Returns the half open range [from, to) of elements equal to target according to the comparer.
+// The slice must be sorted by the comparer. Returns -1, -1 if comparer is nil.
+func EqualRangeWith[T any] (slice []T, target T, comparer func(T, T) int) (int, int) {
+    return LowerBoundWith(slice, target, comparer), UpperBoundWith(slice, target, comparer)
+}
+
+// Returns a copy of the sorted slice with elem inserted after any equal elements, keeping it sorted.
+// The original slice remains unchanged.
+func SortedInsert[T constraints.Ordered] (slice []T, elem T) []T {
+    return insertAt(slice, UpperBound(slice, elem), elem)
+}
+
+// Returns a copy of the slice sorted by the key with elem inserted after any elements of equal key.
+// The original slice remains unchanged. Returns an empty slice if key is nil.
+func SortedInsertBy[T any, K constraints.Ordered] (slice []T, elem T, key func(T) K) []T {
+    if key == nil { return []T{} }
+    return insertAt(slice, UpperBoundBy(slice, key, key(elem)), elem)
+}
+
+// Returns a copy of the slice sorted by the comparer with elem inserted after any equal elements.
+// The original slice remains unchanged. Returns an empty slice if comparer is nil.
+func SortedInsertWith[T any] (slice []T, elem T, comparer func(T, T) int) []T {
+    if comparer == nil { return []T{} }
+    return insertAt(slice, UpperBoundWith(slice, elem, comparer), elem)
+}
+
+// compareAt(i) compares the element at index i with the target
+func lowerBound(n int, compareAt func(int) int) int {
+    return sort.Search(n, func(i int) bool { return compareAt(i) >= 0 })
+}
+
+func upperBound(n int, compareAt func(int) int) int {
+    return sort.Search(n, func(i int) bool { return compareAt(i) > 0 })
+}
+
+func searchIndex(n int, compareAt func(int) int) int {
+    index := lowerBound(n, compareAt)
+    if index < n && compareAt(index) == 0 { return index }
+    return -1
+}
+
+func insertAt[T any] (slice []T, index int, elem T) []T {
+    inserted := make([]T, 0, len(slice) + 1)
+    inserted = append(inserted, slice[:index]...)
+    inserted = append(inserted, elem)
+    return append(inserted, slice[index:]...)
+}
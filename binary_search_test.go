@@ -0,0 +1,59 @@
+package gollections_test
+
+import (
+	"strings"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for binary search", func() {
+
+    list := []int{1, 3, 3, 3, 5, 8}
+    words := []string{"a", "bb", "cc", "ddd", "eeee"}
+    length := func(s string) int { return len(s) }
+    ignoreCase := func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) }
+
+    Context("BinarySearch()", func() {
+        It("Should return the first index of the target or -1", func() {
+            Expect(BinarySearch(list, 3)).Should(Equal(1))
+            Expect(BinarySearch(list, 8)).Should(Equal(5))
+            Expect(BinarySearch(list, 4)).Should(Equal(-1))
+            Expect(BinarySearch([]int{}, 4)).Should(Equal(-1))
+            Expect(BinarySearchBy(words, length, 2)).Should(Equal(1))
+            Expect(BinarySearchBy(words, length, 5)).Should(Equal(-1))
+            Expect(BinarySearchBy[string, int](words, nil, 2)).Should(Equal(-1))
+            Expect(BinarySearchWith([]string{"Apple", "banana", "Cherry"}, "CHERRY", ignoreCase)).Should(Equal(2))
+            Expect(BinarySearchWith(list, 3, nil)).Should(Equal(-1))
+        })
+    })
+
+    Context("LowerBound(), UpperBound() and EqualRange()", func() {
+        It("Should return insertion points", func() {
+            Expect(LowerBound(list, 3)).Should(Equal(1))
+            Expect(UpperBound(list, 3)).Should(Equal(4))
+            Expect(LowerBound(list, 0)).Should(Equal(0))
+            Expect(UpperBound(list, 9)).Should(Equal(6))
+            from, to := EqualRange(list, 3)
+            Expect([]int{from, to}).Should(Equal([]int{1, 4}))
+            from, to = EqualRange(list, 6)
+            Expect([]int{from, to}).Should(Equal([]int{5, 5}))
+            from, to = EqualRangeBy(words, length, 2)
+            Expect([]int{from, to}).Should(Equal([]int{1, 3}))
+            from, to = EqualRangeWith([]string{"a", "B", "b", "c"}, "b", ignoreCase)
+            Expect([]int{from, to}).Should(Equal([]int{1, 3}))
+            Expect(LowerBoundWith(list, 3, nil)).Should(Equal(-1))
+        })
+    })
+
+    Context("SortedInsert()", func() {
+        It("Should insert keeping the slice sorted", func() {
+            Expect(SortedInsert(list, 4)).Should(Equal([]int{1, 3, 3, 3, 4, 5, 8}))
+            Expect(SortedInsert(list, 0)).Should(Equal([]int{0, 1, 3, 3, 3, 5, 8}))
+            Expect(SortedInsert([]int{}, 2)).Should(Equal([]int{2}))
+            Expect(list).Should(Equal([]int{1, 3, 3, 3, 5, 8}))
+            Expect(SortedInsertBy(words, "xx", length)).Should(Equal([]string{"a", "bb", "cc", "xx", "ddd", "eeee"}))
+            Expect(SortedInsertWith([]string{"a", "C"}, "B", ignoreCase)).Should(Equal([]string{"a", "B", "C"}))
+            Expect(SortedInsertWith(list, 2, nil)).Should(Equal([]int{}))
+        })
+    })
+})
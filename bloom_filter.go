@@ -0,0 +1,237 @@
+package gollections
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+const (
+	bloomFormatVersion = 1
+	bloomHeaderSize = 14
+	// Most hash functions newBloomHasher picks, reached at the lowest false positive rate of 1e-12
+	bloomMaxHashes = 40
+)
+
+// Probabilistic set membership.
+// MayContain never gives false negatives, and gives false positives
+// at roughly the rate the filter was sized for.
+type BloomFilter[T any] struct {
+    bloomHasher[T]
+    bits []uint64
+}
+
+// Bloom filter with small counters instead of bits, so elements can be removed.
+// Counters saturate at 255, after which they are never decremented.
+type CountingBloomFilter[T any] struct {
+    bloomHasher[T]
+    counters []uint8
+}
+
+// Size of the filter and how its elements are hashed.
+// Hashing is stable across processes so serialized filters stay valid.
+type bloomHasher[T any] struct {
+    m uint64
+    k uint32
+    encode func(T) []byte
+}
+
+// Returns an empty bloom filter sized for expectedItems elements
+// at the given false positive rate.
+// The encode function turns elements into bytes for hashing. If nil, strings and
+// byte slices are used as is and other values are formatted with fmt.
+func NewBloomFilter[T any] (expectedItems int, falsePositiveRate float64, encode func(T) []byte) *BloomFilter[T] {
+    hasher := newBloomHasher(expectedItems, falsePositiveRate, encode)
+    return &BloomFilter[T]{hasher, make([]uint64, (hasher.m + 63) / 64)}
+}
+
+// Returns an empty counting bloom filter sized for expectedItems elements
+// at the given false positive rate. See NewBloomFilter for encode.
+func NewCountingBloomFilter[T any] (expectedItems int, falsePositiveRate float64, encode func(T) []byte) *CountingBloomFilter[T] {
+    hasher := newBloomHasher(expectedItems, falsePositiveRate, encode)
+    return &CountingBloomFilter[T]{hasher, make([]uint8, hasher.m)}
+}
+
+// Adds the element to the filter
+func (f *BloomFilter[T]) Add(elem T) {
+    f.positions(elem, func(i uint64) bool {
+        f.bits[i / 64] |= 1 << (i % 64)
+        return true
+    })
+}
+
+// Returns false if the element was definitely never added,
+// true if it probably was
+func (f *BloomFilter[T]) MayContain(elem T) bool {
+    return f.positions(elem, func(i uint64) bool {
+        return f.bits[i / 64] & (1 << (i % 64)) != 0
+    })
+}
+
+// Adds every element of other to this filter.
+// Both filters must have been created with the same size parameters.
+func (f *BloomFilter[T]) Union(other *BloomFilter[T]) error {
+    if err := f.compatible(other.bloomHasher); err != nil { return err }
+    for i := range f.bits {
+        f.bits[i] |= other.bits[i]
+    }
+    return nil
+}
+
+// Encodes the filter so it can be persisted and loaded back with UnmarshalBinary
+func (f *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+    data := f.header('B', len(f.bits) * 8)
+    for _, word := range f.bits {
+        data = binary.BigEndian.AppendUint64(data, word)
+    }
+    return data, nil
+}
+
+// Replaces the filter with one encoded by MarshalBinary.
+// The encode function of the filter is kept, so it must match the one used originally.
+func (f *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+    hasher, payload, err := readBloomHeader(f.encode, 'B', data)
+    if err != nil { return err }
+    words := hasher.m / 64
+    if hasher.m % 64 != 0 {
+        words++
+    }
+    if uint64(len(payload)) != words * 8 {
+        return errors.New("bloom filter data has the wrong length")
+    }
+    f.bloomHasher = hasher
+    f.bits = make([]uint64, len(payload) / 8)
+    for i := range f.bits {
+        f.bits[i] = binary.BigEndian.Uint64(payload[i * 8:])
+    }
+    return nil
+}
+
+// Adds the element to the filter
+func (f *CountingBloomFilter[T]) Add(elem T) {
+    f.positions(elem, func(i uint64) bool {
+        if f.counters[i] < math.MaxUint8 {
+            f.counters[i]++
+        }
+        return true
+    })
+}
+
+// Removes one occurrence of the element.
+// Returns false without changing anything if the element was definitely never added.
+// Removing an element that was not added can introduce false negatives.
+func (f *CountingBloomFilter[T]) Remove(elem T) bool {
+    if !f.MayContain(elem) { return false }
+    f.positions(elem, func(i uint64) bool {
+        if f.counters[i] < math.MaxUint8 {
+            f.counters[i]--
+        }
+        return true
+    })
+    return true
+}
+
+// Returns false if the element is definitely not in the filter,
+// true if it probably is
+func (f *CountingBloomFilter[T]) MayContain(elem T) bool {
+    return f.positions(elem, func(i uint64) bool {
+        return f.counters[i] > 0
+    })
+}
+
+// Adds every element of other to this filter.
+// Both filters must have been created with the same size parameters.
+func (f *CountingBloomFilter[T]) Union(other *CountingBloomFilter[T]) error {
+    if err := f.compatible(other.bloomHasher); err != nil { return err }
+    for i, count := range other.counters {
+        f.counters[i] = uint8(min(int(f.counters[i]) + int(count), math.MaxUint8))
+    }
+    return nil
+}
+
+// Encodes the filter so it can be persisted and loaded back with UnmarshalBinary
+func (f *CountingBloomFilter[T]) MarshalBinary() ([]byte, error) {
+    return append(f.header('C', len(f.counters)), f.counters...), nil
+}
+
+// Replaces the filter with one encoded by MarshalBinary.
+// The encode function of the filter is kept, so it must match the one used originally.
+func (f *CountingBloomFilter[T]) UnmarshalBinary(data []byte) error {
+    hasher, payload, err := readBloomHeader(f.encode, 'C', data)
+    if err != nil { return err }
+    if uint64(len(payload)) != hasher.m {
+        return errors.New("bloom filter data has the wrong length")
+    }
+    f.bloomHasher = hasher
+    f.counters = append([]uint8(nil), payload...)
+    return nil
+}
+
+func newBloomHasher[T any] (expectedItems int, falsePositiveRate float64, encode func(T) []byte) bloomHasher[T] {
+    n := float64(max(expectedItems, 1))
+    p := math.Min(math.Max(falsePositiveRate, 1e-12), 0.5)
+    m := math.Ceil(-n * math.Log(p) / (math.Ln2 * math.Ln2))
+    k := math.Max(1, math.Round(m / n * math.Ln2))
+    if encode == nil {
+        encode = defaultBloomEncode[T]
+    }
+    return bloomHasher[T]{uint64(m), uint32(k), encode}
+}
+
+func defaultBloomEncode[T any] (elem T) []byte {
+    switch value := any(elem).(type) {
+    case string:
+        return []byte(value)
+    case []byte:
+        return value
+    }
+    return fmt.Append(nil, elem)
+}
+
+// Calls visit with each of the k bit positions of the element,
+// stopping early and returning false once visit returns false.
+// Positions come from double hashing the two halves of FNV-128a.
+func (h *bloomHasher[T]) positions(elem T, visit func(uint64) bool) bool {
+    hash := fnv.New128a()
+    hash.Write(h.encode(elem))
+    sum := hash.Sum(nil)
+    h1, h2 := binary.BigEndian.Uint64(sum[:8]), binary.BigEndian.Uint64(sum[8:])
+    for i := uint64(0); i < uint64(h.k); i++ {
+        if !visit((h1 + i * h2) % h.m) { return false }
+    }
+    return true
+}
+
+func (h *bloomHasher[T]) compatible(other bloomHasher[T]) error {
+    if h.m != other.m || h.k != other.k {
+        return errors.New("bloom filters have different sizes")
+    }
+    return nil
+}
+
+// Header layout: kind, version, m as uint64 and k as uint32, all big endian
+func (h *bloomHasher[T]) header(kind byte, payloadSize int) []byte {
+    data := make([]byte, 0, bloomHeaderSize + payloadSize)
+    data = append(data, kind, bloomFormatVersion)
+    data = binary.BigEndian.AppendUint64(data, h.m)
+    return binary.BigEndian.AppendUint32(data, h.k)
+}
+
+// Returns the hasher described by the header and the payload following it.
+// Rejects any m the payload cannot hold, so callers can do arithmetic with it safely.
+func readBloomHeader[T any] (encode func(T) []byte, kind byte, data []byte) (bloomHasher[T], []byte, error) {
+    if len(data) < bloomHeaderSize || data[0] != kind || data[1] != bloomFormatVersion {
+        return bloomHasher[T]{}, nil, errors.New("invalid bloom filter data")
+    }
+    m, k := binary.BigEndian.Uint64(data[2:]), binary.BigEndian.Uint32(data[10:])
+    payload := data[bloomHeaderSize:]
+    if m == 0 || k == 0 || m > uint64(len(payload)) * 8 || k > bloomMaxHashes || uint64(k) > m {
+        return bloomHasher[T]{}, nil, errors.New("invalid bloom filter data")
+    }
+    if encode == nil {
+        encode = defaultBloomEncode[T]
+    }
+    return bloomHasher[T]{m, k, encode}, payload, nil
+}
@@ -0,0 +1,127 @@
+package gollections_test
+
+import (
+	"fmt"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for bloom filters", func() {
+
+    Context("BloomFilter", func() {
+        It("Should have no false negatives and few false positives", func() {
+            filter := NewBloomFilter[int](1000, 0.01, nil)
+            for i := 0; i < 1000; i++ {
+                filter.Add(i)
+            }
+            for i := 0; i < 1000; i++ {
+                Expect(filter.MayContain(i)).Should(BeTrue())
+            }
+            falsePositives := len(Filter(MapIndexed(make([]int, 10000), func(i, _ int) int { return i + 1000 }), filter.MayContain))
+            Expect(falsePositives < 300).Should(BeTrue())
+        })
+
+        It("Should union filters of the same size", func() {
+            a := NewBloomFilter[string](100, 0.01, nil)
+            b := NewBloomFilter[string](100, 0.01, nil)
+            a.Add("hello")
+            b.Add("world")
+            Expect(a.Union(b)).Should(BeNil())
+            Expect(a.MayContain("hello")).Should(BeTrue())
+            Expect(a.MayContain("world")).Should(BeTrue())
+            Expect(a.Union(NewBloomFilter[string](10000, 0.01, nil))).ShouldNot(BeNil())
+        })
+
+        It("Should round trip through binary encoding", func() {
+            encode := func(p Pair[int, string]) []byte { return []byte(fmt.Sprintf("%d/%s", p.First, p.Second)) }
+            filter := NewBloomFilter(50, 0.001, encode)
+            filter.Add(Pair[int, string]{1, "a"})
+            data, err := filter.MarshalBinary()
+            Expect(err).Should(BeNil())
+
+            loaded := NewBloomFilter(1, 0.5, encode)
+            Expect(loaded.UnmarshalBinary(data)).Should(BeNil())
+            Expect(loaded.MayContain(Pair[int, string]{1, "a"})).Should(BeTrue())
+            Expect(loaded.MayContain(Pair[int, string]{2, "b"})).Should(BeFalse())
+            Expect(loaded.UnmarshalBinary(data[:10])).ShouldNot(BeNil())
+            Expect(loaded.UnmarshalBinary(data[:len(data) - 1])).ShouldNot(BeNil())
+            Expect(loaded.MayContain(Pair[int, string]{1, "a"})).Should(BeTrue())
+
+            var zero BloomFilter[Pair[int, string]]
+            Expect(zero.UnmarshalBinary(data)).Should(BeNil())
+        })
+
+        It("Should reject a header whose size does not fit the payload", func() {
+            filter := NewBloomFilter[string](10, 0.01, nil)
+            filter.Add("a")
+            crafted := []byte{'B', 1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0, 0, 0, 1}
+            Expect(filter.UnmarshalBinary(crafted)).ShouldNot(BeNil())
+            Expect(filter.UnmarshalBinary(append(crafted, make([]byte, 8)...))).ShouldNot(BeNil())
+            Expect(filter.MayContain("a")).Should(BeTrue())
+            counting := NewCountingBloomFilter[string](10, 0.01, nil)
+            crafted[0] = 'C'
+            Expect(counting.UnmarshalBinary(crafted)).ShouldNot(BeNil())
+            Expect(counting.MayContain("a")).Should(BeFalse())
+        })
+
+        It("Should reject a header with more hash functions than it can use", func() {
+            filter := NewBloomFilter[string](10, 0.01, nil)
+            filter.Add("a")
+            crafted := append([]byte{'B', 1, 0, 0, 0, 0, 0, 0, 0, 64, 0xff, 0xff, 0xff, 0xff}, make([]byte, 8)...)
+            Expect(filter.UnmarshalBinary(crafted)).ShouldNot(BeNil())
+            copy(crafted[9:], []byte{8, 0, 0, 0, 9})
+            Expect(filter.UnmarshalBinary(crafted)).ShouldNot(BeNil())
+            Expect(filter.MayContain("a")).Should(BeTrue())
+            crafted[9], crafted[13] = 64, 41
+            Expect(filter.UnmarshalBinary(crafted)).ShouldNot(BeNil())
+            crafted[13] = 40
+            Expect(filter.UnmarshalBinary(crafted)).Should(BeNil())
+            counting := NewCountingBloomFilter[string](10, 0.01, nil)
+            counting.Add("a")
+            counting.Add("a")
+            crafted = append([]byte{'C', 1, 0, 0, 0, 0, 0, 0, 0, 64, 0xff, 0xff, 0xff, 0xff}, make([]byte, 64)...)
+            Expect(counting.UnmarshalBinary(crafted)).ShouldNot(BeNil())
+            Expect(counting.MayContain("a")).Should(BeTrue())
+        })
+
+        It("Should round trip a filter with the lowest false positive rate", func() {
+            filter := NewBloomFilter[string](1, 1e-15, nil)
+            filter.Add("a")
+            data, err := filter.MarshalBinary()
+            Expect(err).Should(BeNil())
+            restored := NewBloomFilter[string](10, 0.01, nil)
+            Expect(restored.UnmarshalBinary(data)).Should(BeNil())
+            Expect(restored.MayContain("a")).Should(BeTrue())
+        })
+    })
+
+    Context("CountingBloomFilter", func() {
+        It("Should support removal", func() {
+            filter := NewCountingBloomFilter[string](100, 0.01, nil)
+            filter.Add("a")
+            filter.Add("a")
+            filter.Add("b")
+            Expect(filter.Remove("a")).Should(BeTrue())
+            Expect(filter.MayContain("a")).Should(BeTrue())
+            Expect(filter.Remove("a")).Should(BeTrue())
+            Expect(filter.MayContain("a")).Should(BeFalse())
+            Expect(filter.Remove("a")).Should(BeFalse())
+            Expect(filter.MayContain("b")).Should(BeTrue())
+        })
+
+        It("Should union and round trip through binary encoding", func() {
+            a := NewCountingBloomFilter[string](100, 0.01, nil)
+            b := NewCountingBloomFilter[string](100, 0.01, nil)
+            a.Add("x")
+            b.Add("y")
+            Expect(a.Union(b)).Should(BeNil())
+            data, _ := a.MarshalBinary()
+            loaded := NewCountingBloomFilter[string](1, 0.1, nil)
+            Expect(loaded.UnmarshalBinary(data)).Should(BeNil())
+            Expect(loaded.MayContain("x") && loaded.MayContain("y")).Should(BeTrue())
+
+            plain, _ := NewBloomFilter[string](100, 0.01, nil).MarshalBinary()
+            Expect(loaded.UnmarshalBinary(plain)).ShouldNot(BeNil())
+        })
+    })
+})
@@ -0,0 +1,170 @@
+package gollections
+
+import (
+	"sync"
+	"time"
+)
+
+// Fixed capacity cache with optional per entry expiry
+type Cache[K comparable, V any] interface {
+    // Returns the value of the key, counting as a use of the entry
+    Get(key K) (V, bool)
+    // Returns the value of the key without counting as a use of the entry
+    Peek(key K) (V, bool)
+    // Sets the value of the key using the default TTL of the cache
+    Put(key K, value V)
+    // Sets the value of the key, expiring after ttl. A non-positive ttl never expires.
+    PutWithTTL(key K, value V, ttl time.Duration)
+    // Removes the key, returns whether it was there
+    Remove(key K) bool
+    Len() int
+    Cap() int
+    Stats() CacheStats
+}
+
+// Usage statistics of a cache
+type CacheStats struct {
+    Hits uint64
+    Misses uint64
+    // Entries dropped to make room for new ones
+    Evictions uint64
+    // Entries dropped because their TTL passed
+    Expirations uint64
+}
+
+// Settings and bookkeeping shared by the cache implementations
+type cacheCore[K comparable, V any] struct {
+    capacity int
+    onEvict func(K, V)
+    ttl time.Duration
+    clock Clock
+    stats CacheStats
+}
+
+type cacheEntry[V any] struct {
+    value V
+    expiresAt time.Time
+}
+
+// Sets the callback run with every entry that is evicted or expires.
+// Entries replaced by Put or dropped by Remove are not reported.
+func (c *cacheCore[K, V]) SetOnEvict(onEvict func(K, V)) {
+    c.onEvict = onEvict
+}
+
+// Sets the TTL used by Put. A non-positive ttl never expires.
+func (c *cacheCore[K, V]) SetTTL(ttl time.Duration) {
+    c.ttl = ttl
+}
+
+// Sets the clock used to check expiry, SystemClock if nil
+func (c *cacheCore[K, V]) SetClock(clock Clock) {
+    c.clock = clock
+}
+
+// Returns the usage statistics of the cache
+func (c *cacheCore[K, V]) Stats() CacheStats {
+    return c.stats
+}
+
+// Returns the capacity of the cache
+func (c *cacheCore[K, V]) Cap() int {
+    return c.capacity
+}
+
+func (c *cacheCore[K, V]) now() time.Time {
+    if c.clock == nil {
+        return time.Now()
+    }
+    return c.clock.Now()
+}
+
+func (c *cacheCore[K, V]) entry(value V, ttl time.Duration) cacheEntry[V] {
+    if ttl <= 0 {
+        return cacheEntry[V]{value: value}
+    }
+    return cacheEntry[V]{value, c.now().Add(ttl)}
+}
+
+func (c *cacheCore[K, V]) expired(entry cacheEntry[V]) bool {
+    return !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt)
+}
+
+func (c *cacheCore[K, V]) record(hit bool) {
+    if hit {
+        c.stats.Hits++
+    } else {
+        c.stats.Misses++
+    }
+}
+
+func (c *cacheCore[K, V]) evicted(key K, value V, expired bool) {
+    if expired {
+        c.stats.Expirations++
+    } else {
+        c.stats.Evictions++
+    }
+    if c.onEvict != nil {
+        c.onEvict(key, value)
+    }
+}
+
+// Cache wrapper that is safe for concurrent use.
+// Eviction callbacks run while the lock is held.
+type SyncCache[K comparable, V any] struct {
+    mu sync.Mutex
+    cache Cache[K, V]
+}
+
+// Returns a wrapper serializing all access to the given cache
+func NewSyncCache[K comparable, V any] (cache Cache[K, V]) *SyncCache[K, V] {
+    return &SyncCache[K, V]{cache: cache}
+}
+
+func (s *SyncCache[K, V]) Get(key K) (V, bool) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.cache.Get(key)
+}
+
+func (s *SyncCache[K, V]) Peek(key K) (V, bool) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.cache.Peek(key)
+}
+
+func (s *SyncCache[K, V]) Put(key K, value V) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.cache.Put(key, value)
+}
+
+func (s *SyncCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.cache.PutWithTTL(key, value, ttl)
+}
+
+func (s *SyncCache[K, V]) Remove(key K) bool {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.cache.Remove(key)
+}
+
+func (s *SyncCache[K, V]) Len() int {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.cache.Len()
+}
+
+func (s *SyncCache[K, V]) Cap() int {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.cache.Cap()
+}
+
+func (s *SyncCache[K, V]) Stats() CacheStats {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.cache.Stats()
+}
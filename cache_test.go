@@ -0,0 +1,39 @@
+package gollections_test
+
+import (
+	"fmt"
+	"sync"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for cache wrappers", func() {
+
+    Context("SyncCache", func() {
+        It("Should be safe for concurrent use", func() {
+            for _, inner := range []Cache[string, int]{NewLRUCache[string, int](50), NewLFUCache[string, int](50)} {
+                cache := NewSyncCache(inner)
+                var wg sync.WaitGroup
+                for worker := 0; worker < 8; worker++ {
+                    wg.Add(1)
+                    go func() {
+                        defer wg.Done()
+                        for i := 0; i < 200; i++ {
+                            key := fmt.Sprint(i % 80)
+                            cache.Put(key, i)
+                            cache.Get(key)
+                            cache.Peek(key)
+                        }
+                    }()
+                }
+                wg.Wait()
+                Expect(cache.Len()).Should(Equal(50))
+                Expect(cache.Cap()).Should(Equal(50))
+                stats := cache.Stats()
+                Expect(stats.Hits + stats.Misses).Should(Equal(uint64(1600)))
+                cache.Put("last", 1)
+                Expect(cache.Remove("last")).Should(BeTrue())
+            }
+        })
+    })
+})
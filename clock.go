@@ -0,0 +1,53 @@
+package gollections
+
+import (
+	"sync"
+	"time"
+)
+
+// Source of the current time.
+// Structures that deal with expiry take a Clock so tests can control time.
+type Clock interface {
+    Now() time.Time
+}
+
+// Clock that reads the system time
+type SystemClock struct{}
+
+// Returns the current system time
+func (SystemClock) Now() time.Time {
+    return time.Now()
+}
+
+// Clock that only moves when told to, meant for tests.
+// It is safe for concurrent use.
+type ManualClock struct {
+    mu sync.Mutex
+    now time.Time
+}
+
+// Returns a manual clock set to the given time
+func NewManualClock(start time.Time) *ManualClock {
+    return &ManualClock{now: start}
+}
+
+// Returns the current time of the clock
+func (c *ManualClock) Now() time.Time {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    return c.now
+}
+
+// Moves the clock forward by d
+func (c *ManualClock) Advance(d time.Duration) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.now = c.now.Add(d)
+}
+
+// Sets the clock to the given time
+func (c *ManualClock) Set(now time.Time) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.now = now
+}
@@ -0,0 +1,99 @@
+package gollections
+
+import (
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Compares the elements in their natural order, usable wherever a comparer is expected.
+// Returns a negative number if a < b, positive if a > b and 0 if they are equal.
+// NaN is ordered before every other float and equal to itself.
+func ComparingOrdered[T constraints.Ordered] (a, b T) int {
+    return compareOrdered(a, b)
+}
+
+// Returns a comparer ordering elements by their key in ascending order
+func Comparing[T any, K constraints.Ordered] (key func(T) K) func(T, T) int {
+    return func(a, b T) int { return compareOrdered(key(a), key(b)) }
+}
+
+// Returns a comparer ordering elements by their key using the key comparer
+func ComparingWith[T any, K any] (key func(T) K, comparer func(K, K) int) func(T, T) int {
+    return func(a, b T) int { return comparer(key(a), key(b)) }
+}
+
+// Returns a comparer with the opposite order of the given one
+func Reverse[T any] (comparer func(T, T) int) func(T, T) int {
+    return func(a, b T) int { return comparer(b, a) }
+}
+
+// Returns a comparer ordering by the comparer first and by next when it finds elements equal.
+// A nil comparer is skipped, so ThenComparing(nil, next) orders by next alone.
+func ThenComparing[T any] (comparer func(T, T) int, next func(T, T) int) func(T, T) int {
+    if comparer == nil { return next }
+    if next == nil { return comparer }
+    return func(a, b T) int {
+        if result := comparer(a, b); result != 0 { return result }
+        return next(a, b)
+    }
+}
+
+// Returns a comparer of pointers that puts nil before everything
+// and compares the pointed values with the comparer otherwise
+func NilsFirst[T any] (comparer func(T, T) int) func(*T, *T) int {
+    return func(a, b *T) int {
+        switch {
+        case a == nil && b == nil:
+            return 0
+        case a == nil:
+            return -1
+        case b == nil:
+            return 1
+        }
+        return comparer(*a, *b)
+    }
+}
+
+// Returns a comparer of pointers that puts nil after everything
+// and compares the pointed values with the comparer otherwise
+func NilsLast[T any] (comparer func(T, T) int) func(*T, *T) int {
+    return func(a, b *T) int {
+        switch {
+        case a == nil && b == nil:
+            return 0
+        case a == nil:
+            return 1
+        case b == nil:
+            return -1
+        }
+        return comparer(*a, *b)
+    }
+}
+
+// Compares strings the way people read them, with runs of digits compared by their numeric value,
+// so "file2" comes before "file10".
+// Strings that only differ in leading zeros are ordered byte by byte.
+func NaturalCompare(a, b string) int {
+    i, j := 0, 0
+    for i < len(a) && j < len(b) {
+        if !isDigit(a[i]) || !isDigit(b[j]) {
+            if a[i] != b[j] { return compareOrdered(a[i], b[j]) }
+            i, j = i + 1, j + 1
+            continue
+        }
+        startA, startB := i, j
+        for i < len(a) && isDigit(a[i]) { i++ }
+        for j < len(b) && isDigit(b[j]) { j++ }
+        numberA := strings.TrimLeft(a[startA:i], "0")
+        numberB := strings.TrimLeft(b[startB:j], "0")
+        if len(numberA) != len(numberB) { return compareOrdered(len(numberA), len(numberB)) }
+        if numberA != numberB { return strings.Compare(numberA, numberB) }
+    }
+    if i < len(a) || j < len(b) { return compareOrdered(len(a) - i, len(b) - j) }
+    return strings.Compare(a, b)
+}
+
+func isDigit(c byte) bool {
+    return c >= '0' && c <= '9'
+}
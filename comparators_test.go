@@ -0,0 +1,60 @@
+package gollections_test
+
+import (
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for comparators", func() {
+
+    type item struct {
+        name string
+        price int
+        rating *int
+    }
+    rating := func(value int) *int { return &value }
+    items := []item{{"pen", 2, rating(4)}, {"book", 12, nil}, {"lamp", 12, rating(5)}, {"mug", 7, rating(3)}}
+    name := func(i item) string { return i.name }
+
+    Context("Comparing() and Reverse()", func() {
+        It("Should plug into MaxOfBy and MinOfBy", func() {
+            byPrice := Comparing(func(i item) int { return i.price })
+            cheapest, _ := MinOfBy(items, byPrice)
+            Expect(cheapest.name).Should(Equal("pen"))
+            priciest, _ := MaxOfBy(items, Reverse(byPrice))
+            Expect(priciest.name).Should(Equal("pen"))
+            largest, _ := MaxOfBy([]int{3, 9, 4}, ComparingOrdered[int])
+            Expect(largest).Should(Equal(9))
+            Expect(Reverse(ComparingOrdered[string])("a", "b")).Should(Equal(1))
+        })
+    })
+
+    Context("ThenComparing()", func() {
+        It("Should break ties with the next comparer", func() {
+            byPriceThenName := ThenComparing(Comparing(func(i item) int { return i.price }), Comparing(name))
+            Expect(Map(SortedWith(items, byPriceThenName), name)).Should(Equal([]string{"pen", "mug", "book", "lamp"}))
+            Expect(ThenComparing(nil, ComparingOrdered[int])(1, 2)).Should(Equal(-1))
+            Expect(ThenComparing(ComparingOrdered[int], nil)(2, 1)).Should(Equal(1))
+        })
+    })
+
+    Context("NilsFirst() and NilsLast()", func() {
+        It("Should order nil pointers at either end", func() {
+            ratingOf := func(i item) *int { return i.rating }
+            nilsFirst := ComparingWith(ratingOf, NilsFirst(ComparingOrdered[int]))
+            nilsLast := ComparingWith(ratingOf, NilsLast(ComparingOrdered[int]))
+            Expect(Map(SortedWith(items, nilsFirst), name)).Should(Equal([]string{"book", "mug", "pen", "lamp"}))
+            Expect(Map(SortedWith(items, nilsLast), name)).Should(Equal([]string{"mug", "pen", "lamp", "book"}))
+            Expect(NilsFirst(ComparingOrdered[int])(nil, nil)).Should(Equal(0))
+        })
+    })
+
+    Context("NaturalCompare()", func() {
+        It("Should compare digit runs by value", func() {
+            files := []string{"file10.txt", "file2.txt", "File1.txt", "file1.txt", "file02.txt", "file", "v1.10", "v1.9"}
+            Expect(SortedWith(files, NaturalCompare)).Should(Equal(
+                []string{"File1.txt", "file", "file1.txt", "file02.txt", "file2.txt", "file10.txt", "v1.9", "v1.10"}))
+            Expect(NaturalCompare("a007", "a7")).Should(Equal(-1))
+            Expect(NaturalCompare("x", "x")).Should(Equal(0))
+        })
+    })
+})
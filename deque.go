@@ -0,0 +1,124 @@
+package gollections
+
+import "iter"
+
+const dequeMinCapacity = 8
+
+// Double ended queue backed by a growable circular buffer.
+// Pushes and pops at both ends are O(1) amortized.
+// The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+    buf []T
+    head, size int
+}
+
+// Returns a deque containing the given elements from front to back
+func NewDeque[T any] (elems ...T) *Deque[T] {
+    deque := &Deque[T]{}
+    for _, elem := range elems {
+        deque.PushBack(elem)
+    }
+    return deque
+}
+
+// Returns the number of elements in the deque
+func (d *Deque[T]) Len() int {
+    return d.size
+}
+
+// Adds the element at the front
+func (d *Deque[T]) PushFront(elem T) {
+    d.grow()
+    d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+    d.buf[d.head] = elem
+    d.size++
+}
+
+// Adds the element at the back
+func (d *Deque[T]) PushBack(elem T) {
+    d.grow()
+    d.buf[(d.head + d.size) % len(d.buf)] = elem
+    d.size++
+}
+
+// Removes and returns the element at the front, false if empty
+func (d *Deque[T]) PopFront() (T, bool) {
+    if d.size == 0 { return zero[T](), false }
+    elem := d.buf[d.head]
+    d.buf[d.head] = zero[T]()
+    d.head = (d.head + 1) % len(d.buf)
+    d.size--
+    return elem, true
+}
+
+// Removes and returns the element at the back, false if empty
+func (d *Deque[T]) PopBack() (T, bool) {
+    if d.size == 0 { return zero[T](), false }
+    index := (d.head + d.size - 1) % len(d.buf)
+    elem := d.buf[index]
+    d.buf[index] = zero[T]()
+    d.size--
+    return elem, true
+}
+
+// Returns the element at the front without removing it, false if empty
+func (d *Deque[T]) PeekFront() (T, bool) {
+    return d.Get(0)
+}
+
+// Returns the element at the back without removing it, false if empty
+func (d *Deque[T]) PeekBack() (T, bool) {
+    return d.Get(d.size - 1)
+}
+
+// Returns the element at index counted from the front, false if the index is invalid
+func (d *Deque[T]) Get(index int) (T, bool) {
+    if index < 0 || index >= d.size { return zero[T](), false }
+    return d.buf[(d.head + index) % len(d.buf)], true
+}
+
+// Replaces the element at index counted from the front.
+// Returns false if the index is invalid.
+func (d *Deque[T]) Set(index int, elem T) bool {
+    if index < 0 || index >= d.size { return false }
+    d.buf[(d.head + index) % len(d.buf)] = elem
+    return true
+}
+
+// Removes all elements from the deque
+func (d *Deque[T]) Clear() {
+    clear(d.buf)
+    d.head, d.size = 0, 0
+}
+
+// Returns a sequence over the indices and elements from front to back
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+    return func(yield func(int, T) bool) {
+        for i := 0; i < d.size; i++ {
+            if !yield(i, d.buf[(d.head + i) % len(d.buf)]) { return }
+        }
+    }
+}
+
+// Returns a lazy sequence over the elements from front to back
+func (d *Deque[T]) Lazy() Seq[T] {
+    return func(yield func(T) bool) {
+        for _, elem := range d.All() {
+            if !yield(elem) { return }
+        }
+    }
+}
+
+// Returns the elements from front to back as a new slice
+func (d *Deque[T]) ToSlice() []T {
+    return d.Lazy().Collect()
+}
+
+func (d *Deque[T]) grow() {
+    if d.size < len(d.buf) { return }
+    buf := make([]T, max(dequeMinCapacity, 2 * len(d.buf)))
+    for i := 0; i < d.size; i++ {
+        buf[i] = d.buf[(d.head + i) % len(d.buf)]
+    }
+    d.buf, d.head = buf, 0
+}
@@ -0,0 +1,80 @@
+package gollections_test
+
+import (
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for deque", func() {
+
+    var deque *Deque[int]
+
+    BeforeEach(func() {
+        deque = NewDeque(1, 2, 3)
+    })
+
+    Context("Push and pop", func() {
+        It("Should work at both ends", func() {
+            deque.PushFront(0)
+            deque.PushBack(4)
+            Expect(deque.ToSlice()).Should(Equal([]int{0, 1, 2, 3, 4}))
+            front, _ := deque.PopFront()
+            back, _ := deque.PopBack()
+            Expect(front).Should(Equal(0))
+            Expect(back).Should(Equal(4))
+            Expect(deque.Len()).Should(Equal(3))
+
+            var empty Deque[string]
+            _, found := empty.PopBack()
+            Expect(found).Should(BeFalse())
+            _, found = empty.PeekFront()
+            Expect(found).Should(BeFalse())
+        })
+
+        It("Should grow while wrapped around", func() {
+            var deque Deque[int]
+            expected := []int{}
+            for i := 0; i < 100; i++ {
+                if i % 2 == 0 {
+                    deque.PushFront(i)
+                    expected = append([]int{i}, expected...)
+                } else {
+                    deque.PushBack(i)
+                    expected = append(expected, i)
+                }
+                if i % 5 == 0 {
+                    deque.PopFront()
+                    expected = expected[1:]
+                }
+            }
+            Expect(deque.ToSlice()).Should(Equal(expected))
+        })
+    })
+
+    Context("Indexed access", func() {
+        It("Should get, set and peek", func() {
+            value, _ := deque.Get(1)
+            Expect(value).Should(Equal(2))
+            Expect(deque.Set(1, 20)).Should(BeTrue())
+            Expect(deque.Set(3, 0)).Should(BeFalse())
+            front, _ := deque.PeekFront()
+            back, _ := deque.PeekBack()
+            Expect([]int{front, back}).Should(Equal([]int{1, 3}))
+            _, found := deque.Get(-1)
+            Expect(found).Should(BeFalse())
+            deque.Clear()
+            Expect(deque.Len()).Should(Equal(0))
+        })
+    })
+
+    Context("Iteration", func() {
+        It("Should work with the list utilities", func() {
+            Expect(Filter(deque.ToSlice(), func(x int) bool { return x > 1 })).Should(Equal([]int{2, 3}))
+            Expect(deque.Lazy().Filter(func(x int) bool { return x > 1 }).Collect()).Should(Equal([]int{2, 3}))
+            indices := []int{}
+            for i := range deque.All() {
+                indices = append(indices, i)
+            }
+            Expect(indices).Should(Equal([]int{0, 1, 2}))
+        })
+    })
+})
@@ -0,0 +1,107 @@
+package gollections
+
+// Union-find over comparable elements, with path compression and union by rank.
+// The zero value is an empty set ready to use.
+type DisjointSet[T comparable] struct {
+    index map[T]int
+    // Elements in insertion order, parent, rank and size are indexed alike
+    elems []T
+    parent []int
+    rank []int
+    size []int
+    sets int
+}
+
+// Returns a disjoint set with each element in a set of its own
+func NewDisjointSet[T comparable] (elems ...T) *DisjointSet[T] {
+    set := &DisjointSet[T]{}
+    for _, elem := range elems {
+        set.Add(elem)
+    }
+    return set
+}
+
+// Adds the element in a set of its own, returns false if it was already there
+func (d *DisjointSet[T]) Add(elem T) bool {
+    if d.index == nil {
+        d.index = make(map[T]int)
+    }
+    if _, found := d.index[elem]; found { return false }
+    d.index[elem] = len(d.elems)
+    d.elems = append(d.elems, elem)
+    d.parent = append(d.parent, len(d.parent))
+    d.rank = append(d.rank, 0)
+    d.size = append(d.size, 1)
+    d.sets++
+    return true
+}
+
+// Returns the number of elements
+func (d *DisjointSet[T]) Len() int {
+    return len(d.elems)
+}
+
+// Returns the number of disjoint sets
+func (d *DisjointSet[T]) SetCount() int {
+    return d.sets
+}
+
+// Returns the representative of the set holding the element, false if the element is not there
+func (d *DisjointSet[T]) Find(elem T) (T, bool) {
+    i, found := d.index[elem]
+    if !found { return zero[T](), false }
+    return d.elems[d.root(i)], true
+}
+
+// Merges the sets holding the two elements, adding the elements if needed.
+// Returns false if they were already in the same set.
+func (d *DisjointSet[T]) Union(a, b T) bool {
+    d.Add(a)
+    d.Add(b)
+    rootA, rootB := d.root(d.index[a]), d.root(d.index[b])
+    if rootA == rootB { return false }
+    if d.rank[rootA] < d.rank[rootB] {
+        rootA, rootB = rootB, rootA
+    }
+    d.parent[rootB] = rootA
+    d.size[rootA] += d.size[rootB]
+    if d.rank[rootA] == d.rank[rootB] {
+        d.rank[rootA]++
+    }
+    d.sets--
+    return true
+}
+
+// Returns whether both elements are there and in the same set
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+    i, foundA := d.index[a]
+    j, foundB := d.index[b]
+    return foundA && foundB && d.root(i) == d.root(j)
+}
+
+// Returns the size of the set holding the element, 0 if the element is not there
+func (d *DisjointSet[T]) SetSize(elem T) int {
+    i, found := d.index[elem]
+    if !found { return 0 }
+    return d.size[d.root(i)]
+}
+
+// Returns the sets keyed by their representative.
+// Members of each set are in insertion order.
+func (d *DisjointSet[T]) Groups() map[T][]T {
+    groups := make(map[T][]T)
+    for i, elem := range d.elems {
+        root := d.elems[d.root(i)]
+        groups[root] = append(groups[root], elem)
+    }
+    return groups
+}
+
+// Returns the root of the element at index i, halving the path on the way
+func (d *DisjointSet[T]) root(i int) int {
+    for d.parent[i] != i {
+        d.parent[i] = d.parent[d.parent[i]]
+        i = d.parent[i]
+    }
+    return i
+}
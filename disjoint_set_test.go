@@ -0,0 +1,64 @@
+package gollections_test
+
+import (
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for disjoint set", func() {
+
+    Context("Union() and Find()", func() {
+        It("Should merge sets and track their sizes", func() {
+            set := NewDisjointSet(1, 2, 3, 4, 5)
+            Expect(set.Add(1)).Should(BeFalse())
+            Expect(set.Union(1, 2)).Should(BeTrue())
+            Expect(set.Union(3, 4)).Should(BeTrue())
+            Expect(set.Union(2, 4)).Should(BeTrue())
+            Expect(set.Union(1, 3)).Should(BeFalse())
+            Expect(set.Connected(1, 4)).Should(BeTrue())
+            Expect(set.Connected(1, 5)).Should(BeFalse())
+            Expect(set.Connected(1, 9)).Should(BeFalse())
+            Expect(set.SetSize(3)).Should(Equal(4))
+            Expect(set.SetSize(5)).Should(Equal(1))
+            Expect(set.SetSize(9)).Should(Equal(0))
+            Expect(set.SetCount()).Should(Equal(2))
+            rootA, _ := set.Find(1)
+            rootB, found := set.Find(4)
+            Expect(found).Should(BeTrue())
+            Expect(rootA).Should(Equal(rootB))
+            _, found = set.Find(9)
+            Expect(found).Should(BeFalse())
+        })
+
+        It("Should add missing elements on union", func() {
+            var set DisjointSet[string]
+            set.Union("a", "b")
+            set.Union("c", "c")
+            Expect(set.Len()).Should(Equal(3))
+            Expect(set.SetCount()).Should(Equal(2))
+        })
+
+        It("Should keep long chains shallow", func() {
+            set := NewDisjointSet[int]()
+            for i := 1; i < 100000; i++ {
+                set.Union(i - 1, i)
+            }
+            Expect(set.SetCount()).Should(Equal(1))
+            Expect(set.SetSize(0)).Should(Equal(100000))
+        })
+    })
+
+    Context("Groups()", func() {
+        It("Should group members by representative in insertion order", func() {
+            set := NewDisjointSet("a", "b", "c", "d", "e")
+            set.Union("d", "a")
+            set.Union("e", "c")
+            groups := set.Groups()
+            Expect(groups).Should(HaveLen(3))
+            root, _ := set.Find("a")
+            Expect(groups[root]).Should(Equal([]string{"a", "d"}))
+            root, _ = set.Find("e")
+            Expect(groups[root]).Should(Equal([]string{"c", "e"}))
+            Expect(groups["b"]).Should(Equal([]string{"b"}))
+        })
+    })
+})
@@ -0,0 +1,182 @@
+package gollections
+
+import (
+	"sync"
+	"time"
+)
+
+// Map whose entries expire after a per key TTL.
+// Expired entries are removed lazily when accessed, by Sweep,
+// or periodically once StartSweeper is called.
+// It is safe for concurrent use.
+type ExpiringMap[K comparable, V any] struct {
+    mu sync.Mutex
+    entries map[K]cacheEntry[V]
+    clock Clock
+    onExpire func(K, V)
+    stopSweeper chan struct{}
+    // Closed once the running sweeper goroutine has returned
+    sweeperDone chan struct{}
+}
+
+// Returns an empty expiring map reading time from clock, SystemClock if nil
+func NewExpiringMap[K comparable, V any] (clock Clock) *ExpiringMap[K, V] {
+    if clock == nil {
+        clock = SystemClock{}
+    }
+    return &ExpiringMap[K, V]{entries: make(map[K]cacheEntry[V]), clock: clock}
+}
+
+// Sets the callback run with every entry removed because it expired.
+// The callback runs without the map being locked.
+func (m *ExpiringMap[K, V]) SetOnExpire(onExpire func(K, V)) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    m.onExpire = onExpire
+}
+
+// Sets the value of the key, expiring after ttl. A non-positive ttl never expires.
+func (m *ExpiringMap[K, V]) Set(key K, value V, ttl time.Duration) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    entry := cacheEntry[V]{value: value}
+    if ttl > 0 {
+        entry.expiresAt = m.clock.Now().Add(ttl)
+    }
+    m.entries[key] = entry
+}
+
+// Returns the value of the key and whether the key is there and not expired
+func (m *ExpiringMap[K, V]) Get(key K) (V, bool) {
+    m.mu.Lock()
+    entry, found := m.entries[key]
+    if found && m.expired(entry, m.clock.Now()) {
+        delete(m.entries, key)
+        onExpire := m.onExpire
+        m.mu.Unlock()
+        if onExpire != nil {
+            onExpire(key, entry.value)
+        }
+        return zero[V](), false
+    }
+    m.mu.Unlock()
+    return entry.value, found
+}
+
+// Get the value corresponding to the key, returns default value if the key is not there or expired.
+func (m *ExpiringMap[K, V]) GetOrDefault(key K, defaultVal V) V {
+    if value, found := m.Get(key); found {
+        return value
+    }
+    return defaultVal
+}
+
+// Returns whether the map contains the key and it has not expired
+func (m *ExpiringMap[K, V]) ContainsKey(key K) bool {
+    _, found := m.Get(key)
+    return found
+}
+
+// Returns the time left before the key expires.
+// Returns false if the key is not there, expired or never expires.
+func (m *ExpiringMap[K, V]) TTL(key K) (time.Duration, bool) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    entry, found := m.entries[key]
+    now := m.clock.Now()
+    if !found || entry.expiresAt.IsZero() || m.expired(entry, now) { return 0, false }
+    return entry.expiresAt.Sub(now), true
+}
+
+// Deletes the key, returns whether it was there
+func (m *ExpiringMap[K, V]) Delete(key K) bool {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    _, found := m.entries[key]
+    delete(m.entries, key)
+    return found
+}
+
+// Returns the number of entries, including expired ones not yet removed
+func (m *ExpiringMap[K, V]) Len() int {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return len(m.entries)
+}
+
+// Removes every expired entry and returns how many were removed
+func (m *ExpiringMap[K, V]) Sweep() int {
+    m.mu.Lock()
+    now := m.clock.Now()
+    expired := []*Pair[K, V]{}
+    for key, entry := range m.entries {
+        if m.expired(entry, now) {
+            delete(m.entries, key)
+            expired = append(expired, &Pair[K, V]{key, entry.value})
+        }
+    }
+    onExpire := m.onExpire
+    m.mu.Unlock()
+    if onExpire != nil {
+        ForEach(expired, func(entry *Pair[K, V]) { onExpire(entry.First, entry.Second) })
+    }
+    return len(expired)
+}
+
+// Starts a goroutine calling Sweep every interval until StopSweeper is called.
+// Restarts the sweeper if one is already running.
+// Returns false without starting anything if interval is not positive.
+func (m *ExpiringMap[K, V]) StartSweeper(interval time.Duration) bool {
+    if interval <= 0 { return false }
+    stop, done := make(chan struct{}), make(chan struct{})
+    m.mu.Lock()
+    previous := m.swapSweeper(stop, done)
+    m.mu.Unlock()
+    if previous != nil {
+        <-previous
+    }
+    go func() {
+        defer close(done)
+        ticker := time.NewTicker(interval)
+        defer ticker.Stop()
+        for {
+            select {
+            case <-ticker.C:
+                select {
+                case <-stop:
+                    return
+                default:
+                    m.Sweep()
+                }
+            case <-stop:
+                return
+            }
+        }
+    }()
+    return true
+}
+
+// Stops the sweeper started by StartSweeper, if any.
+// Returns once the sweeper goroutine has exited, so no sweep runs afterwards.
+func (m *ExpiringMap[K, V]) StopSweeper() {
+    m.mu.Lock()
+    previous := m.swapSweeper(nil, nil)
+    m.mu.Unlock()
+    if previous != nil {
+        <-previous
+    }
+}
+
+// Signals the running sweeper to stop, installs the new one and returns the done channel of the old one
+func (m *ExpiringMap[K, V]) swapSweeper(stop, done chan struct{}) chan struct{} {
+    if m.stopSweeper != nil {
+        close(m.stopSweeper)
+    }
+    previous := m.sweeperDone
+    m.stopSweeper, m.sweeperDone = stop, done
+    return previous
+}
+
+func (m *ExpiringMap[K, V]) expired(entry cacheEntry[V], now time.Time) bool {
+    return !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt)
+}
@@ -0,0 +1,113 @@
+package gollections_test
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for expiring map", func() {
+
+    var clock *ManualClock
+    var expiringMap *ExpiringMap[string, int]
+
+    BeforeEach(func() {
+        clock = NewManualClock(time.Unix(0, 0))
+        expiringMap = NewExpiringMap[string, int](clock)
+        expiringMap.Set("short", 1, time.Second)
+        expiringMap.Set("long", 2, time.Minute)
+        expiringMap.Set("forever", 3, 0)
+    })
+
+    Context("Get()", func() {
+        It("Should lazily expire entries", func() {
+            expired := []string{}
+            expiringMap.SetOnExpire(func(key string, value int) { expired = append(expired, key) })
+            Expect(expiringMap.GetOrDefault("short", -1)).Should(Equal(1))
+            clock.Advance(time.Second)
+            Expect(expiringMap.GetOrDefault("short", -1)).Should(Equal(-1))
+            Expect(expiringMap.ContainsKey("long")).Should(BeTrue())
+            Expect(expiringMap.ContainsKey("missing")).Should(BeFalse())
+            Expect(expired).Should(Equal([]string{"short"}))
+            Expect(expiringMap.Len()).Should(Equal(2))
+        })
+    })
+
+    Context("TTL() and Delete()", func() {
+        It("Should report remaining time and delete keys", func() {
+            clock.Advance(20 * time.Second)
+            ttl, found := expiringMap.TTL("long")
+            Expect(ttl).Should(Equal(40 * time.Second))
+            Expect(found).Should(BeTrue())
+            _, found = expiringMap.TTL("forever")
+            Expect(found).Should(BeFalse())
+            _, found = expiringMap.TTL("short")
+            Expect(found).Should(BeFalse())
+            Expect(expiringMap.Delete("long")).Should(BeTrue())
+            Expect(expiringMap.Delete("long")).Should(BeFalse())
+        })
+    })
+
+    Context("Sweep()", func() {
+        It("Should remove every expired entry", func() {
+            expired := []string{}
+            expiringMap.SetOnExpire(func(key string, value int) { expired = append(expired, key) })
+            clock.Advance(time.Hour)
+            Expect(expiringMap.Sweep()).Should(Equal(2))
+            sort.Strings(expired)
+            Expect(expired).Should(Equal([]string{"long", "short"}))
+            Expect(expiringMap.Len()).Should(Equal(1))
+            Expect(expiringMap.Sweep()).Should(Equal(0))
+        })
+
+        It("Should sweep periodically", func() {
+            var mu sync.Mutex
+            expired := []string{}
+            expiringMap.SetOnExpire(func(key string, value int) {
+                mu.Lock()
+                defer mu.Unlock()
+                expired = append(expired, key)
+            })
+            Expect(expiringMap.StartSweeper(time.Millisecond)).Should(BeTrue())
+            defer expiringMap.StopSweeper()
+            clock.Advance(2 * time.Second)
+            Eventually(expiringMap.Len).Should(Equal(2))
+            mu.Lock()
+            defer mu.Unlock()
+            Expect(expired).Should(Equal([]string{"short"}))
+        })
+
+        It("Should not sweep once StopSweeper returns", func() {
+            Expect(expiringMap.StartSweeper(time.Nanosecond)).Should(BeTrue())
+            Expect(expiringMap.StartSweeper(time.Nanosecond)).Should(BeTrue())
+            expiringMap.StopSweeper()
+            expiringMap.StopSweeper()
+            clock.Advance(2 * time.Second)
+            Expect(expiringMap.Len()).Should(Equal(3))
+        })
+
+        It("Should reject a non positive interval", func() {
+            Expect(expiringMap.StartSweeper(0)).Should(BeFalse())
+            Expect(expiringMap.StartSweeper(-time.Second)).Should(BeFalse())
+            expiringMap.StopSweeper()
+        })
+
+        It("Should leave a single sweeper running when started concurrently", func() {
+            var wg sync.WaitGroup
+            for i := 0; i < 50; i++ {
+                wg.Add(1)
+                go func() {
+                    defer wg.Done()
+                    expiringMap.StartSweeper(time.Millisecond)
+                }()
+            }
+            wg.Wait()
+            expiringMap.StopSweeper()
+            clock.Advance(2 * time.Second)
+            Expect(expiringMap.Len()).Should(Equal(3))
+            Expect(expiringMap.Sweep()).Should(Equal(1))
+        })
+    })
+})
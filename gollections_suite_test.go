@@ -14,11 +14,14 @@ var (
 	BeNil          = gomega.BeNil
     BeFalse        = gomega.BeFalse
     BeTrue         = gomega.BeTrue
+    HaveLen        = gomega.HaveLen
 	Context        = ginkgo.Context
 	Describe       = ginkgo.Describe
 	Equal          = gomega.Equal
 	Expect         = gomega.Expect
+    Eventually     = gomega.Eventually
 	It             = ginkgo.It
+    PanicWith      = gomega.PanicWith
 )
 
 func TestGollections(t *testing.T) {
@@ -0,0 +1,348 @@
+package gollections
+
+import "errors"
+
+// Graph of comparable nodes with optionally weighted edges.
+// Nodes and neighbors are kept in insertion order,
+// so traversals and algorithms give deterministic results.
+type Graph[N comparable] struct {
+    directed bool
+    // Outgoing edges of every node along with their weights
+    adjacency *OrderedMap[N, *OrderedMap[N, float64]]
+}
+
+// Weighted edge of a graph
+type Edge[N comparable] struct {
+    From N
+    To N
+    Weight float64
+}
+
+type graphItem[N comparable] struct {
+    node N
+    priority float64
+}
+
+// Returns an empty graph
+func NewGraph[N comparable] (directed bool) *Graph[N] {
+    return &Graph[N]{directed, NewOrderedMap[N, *OrderedMap[N, float64]]()}
+}
+
+// Returns a graph with an edge of weight 1 for each pair
+func GraphFromEdges[N comparable] (edges []*Pair[N, N], directed bool) *Graph[N] {
+    graph := NewGraph[N](directed)
+    for _, edge := range edges {
+        graph.AddEdge(edge.First, edge.Second)
+    }
+    return graph
+}
+
+// Returns whether the edges of the graph are directed
+func (g *Graph[N]) Directed() bool {
+    return g.directed
+}
+
+// Adds the node if it is not in the graph yet
+func (g *Graph[N]) AddNode(node N) {
+    if !g.adjacency.ContainsKey(node) {
+        g.adjacency.Set(node, NewOrderedMap[N, float64]())
+    }
+}
+
+// Adds an edge of weight 1, adding the nodes if needed
+func (g *Graph[N]) AddEdge(from, to N) {
+    g.AddWeightedEdge(from, to, 1)
+}
+
+// Adds an edge with the given weight, adding the nodes if needed.
+// Adding an existing edge replaces its weight.
+func (g *Graph[N]) AddWeightedEdge(from, to N, weight float64) {
+    g.AddNode(from)
+    g.AddNode(to)
+    g.neighbors(from).Set(to, weight)
+    if !g.directed {
+        g.neighbors(to).Set(from, weight)
+    }
+}
+
+// Removes the edge, returns whether it was there
+func (g *Graph[N]) RemoveEdge(from, to N) bool {
+    if !g.HasEdge(from, to) { return false }
+    g.neighbors(from).Delete(to)
+    if !g.directed {
+        g.neighbors(to).Delete(from)
+    }
+    return true
+}
+
+// Returns whether the node is in the graph
+func (g *Graph[N]) HasNode(node N) bool {
+    return g.adjacency.ContainsKey(node)
+}
+
+// Returns whether there is an edge from `from` to `to`
+func (g *Graph[N]) HasEdge(from, to N) bool {
+    _, found := g.Weight(from, to)
+    return found
+}
+
+// Returns the weight of the edge and whether the edge is there
+func (g *Graph[N]) Weight(from, to N) (float64, bool) {
+    if !g.HasNode(from) { return 0, false }
+    return g.neighbors(from).Get(to)
+}
+
+// Returns all nodes in insertion order
+func (g *Graph[N]) Nodes() []N {
+    return g.adjacency.Keys()
+}
+
+// Returns the nodes reachable from the node through one edge
+func (g *Graph[N]) Neighbors(node N) []N {
+    if !g.HasNode(node) { return []N{} }
+    return g.neighbors(node).Keys()
+}
+
+// Returns every edge of the graph.
+// Undirected edges are listed once, from the node added first.
+func (g *Graph[N]) Edges() []Edge[N] {
+    edges := []Edge[N]{}
+    seen := NewSet[N]()
+    for from, neighbors := range g.adjacency.All() {
+        seen.Add(from)
+        for to, weight := range neighbors.All() {
+            if g.directed || !seen.Has(to) || from == to {
+                edges = append(edges, Edge[N]{from, to, weight})
+            }
+        }
+    }
+    return edges
+}
+
+// Returns a lazy breadth first traversal starting from the node
+func (g *Graph[N]) BFS(start N) Seq[N] {
+    return func(yield func(N) bool) {
+        if !g.HasNode(start) { return }
+        visited := NewSet(start)
+        queue := NewDeque(start)
+        for queue.Len() > 0 {
+            node, _ := queue.PopFront()
+            if !yield(node) { return }
+            for neighbor := range g.neighbors(node).All() {
+                if !visited.Has(neighbor) {
+                    visited.Add(neighbor)
+                    queue.PushBack(neighbor)
+                }
+            }
+        }
+    }
+}
+
+// Returns a lazy depth first traversal starting from the node, in preorder
+func (g *Graph[N]) DFS(start N) Seq[N] {
+    return func(yield func(N) bool) {
+        if !g.HasNode(start) { return }
+        visited := NewSet[N]()
+        stack := NewDeque(start)
+        for stack.Len() > 0 {
+            node, _ := stack.PopBack()
+            if visited.Has(node) { continue }
+            visited.Add(node)
+            if !yield(node) { return }
+            neighbors := g.neighbors(node).Keys()
+            for i := len(neighbors) - 1; i >= 0; i-- {
+                if !visited.Has(neighbors[i]) {
+                    stack.PushBack(neighbors[i])
+                }
+            }
+        }
+    }
+}
+
+// Returns the connected components of the graph.
+// Edge directions are ignored, so for directed graphs these are the weakly connected components.
+func (g *Graph[N]) ConnectedComponents() [][]N {
+    undirected := g
+    if g.directed {
+        undirected = NewGraph[N](false)
+        for _, node := range g.Nodes() {
+            undirected.AddNode(node)
+        }
+        for _, edge := range g.Edges() {
+            undirected.AddEdge(edge.From, edge.To)
+        }
+    }
+    components := [][]N{}
+    visited := NewSet[N]()
+    for _, node := range undirected.Nodes() {
+        if visited.Has(node) { continue }
+        component := undirected.BFS(node).Collect()
+        visited.Add(component...)
+        components = append(components, component)
+    }
+    return components
+}
+
+// Returns the strongly connected components of the graph using Tarjan's algorithm.
+// Components are listed in reverse topological order.
+func (g *Graph[N]) StronglyConnectedComponents() [][]N {
+    components := [][]N{}
+    index := map[N]int{}
+    lowLink := map[N]int{}
+    onStack := NewSet[N]()
+    stack := []N{}
+    var connect func(N)
+    connect = func(node N) {
+        index[node] = len(index)
+        lowLink[node] = index[node]
+        stack = append(stack, node)
+        onStack.Add(node)
+        for neighbor := range g.neighbors(node).All() {
+            if _, visited := index[neighbor]; !visited {
+                connect(neighbor)
+                lowLink[node] = min(lowLink[node], lowLink[neighbor])
+            } else if onStack.Has(neighbor) {
+                lowLink[node] = min(lowLink[node], index[neighbor])
+            }
+        }
+        if lowLink[node] != index[node] { return }
+        component := []N{}
+        for {
+            top := stack[len(stack) - 1]
+            stack = stack[:len(stack) - 1]
+            onStack.Remove(top)
+            component = append(component, top)
+            if top == node { break }
+        }
+        components = append(components, component)
+    }
+    for _, node := range g.Nodes() {
+        if _, visited := index[node]; !visited {
+            connect(node)
+        }
+    }
+    return components
+}
+
+// Returns whether the graph contains a cycle.
+// For undirected graphs an edge followed back and forth is not a cycle, but a self loop is.
+func (g *Graph[N]) HasCycle() bool {
+    const (
+        unvisited = iota
+        inProgress
+        done
+    )
+    state := map[N]int{}
+    var visit func(node, parent N, hasParent bool) bool
+    visit = func(node, parent N, hasParent bool) bool {
+        state[node] = inProgress
+        for neighbor := range g.neighbors(node).All() {
+            if !g.directed && hasParent && neighbor == parent && neighbor != node { continue }
+            switch state[neighbor] {
+            case inProgress:
+                return true
+            case done:
+                if !g.directed { return true }
+            case unvisited:
+                if visit(neighbor, node, true) { return true }
+            }
+        }
+        state[node] = done
+        return false
+    }
+    for _, node := range g.Nodes() {
+        if state[node] == unvisited && visit(node, node, false) {
+            return true
+        }
+    }
+    return false
+}
+
+// Returns the lightest path between the nodes and its total weight using Dijkstra's algorithm.
+// Raises error if either node is missing, there is no path or a negative weight is met.
+func (g *Graph[N]) ShortestPath(from, to N) ([]N, float64, error) {
+    return g.AStar(from, to, func(N) float64 { return 0 })
+}
+
+// Returns the lightest path between the nodes and its total weight using A* search.
+// The heuristic estimates the remaining weight to `to` and must never overestimate it.
+// Nodes are reopened when a lighter path to them is found, so the heuristic need not be consistent.
+// Raises error if either node is missing, there is no path or a negative weight is met.
+func (g *Graph[N]) AStar(from, to N, heuristic func(N) float64) ([]N, float64, error) {
+    if !g.HasNode(from) || !g.HasNode(to) {
+        return nil, 0, errors.New("Node is not in the graph")
+    }
+    if heuristic == nil {
+        return nil, 0, errors.New("nil function pointer passed")
+    }
+    distance := map[N]float64{from: 0}
+    previous := map[N]N{}
+    pq := NewPriorityQueue(func(a, b graphItem[N]) int { return compareOrdered(a.priority, b.priority) })
+    handles := map[N]*PQHandle[graphItem[N]]{from: pq.Push(graphItem[N]{from, heuristic(from)})}
+    for pq.Len() > 0 {
+        item, _ := pq.Pop()
+        node := item.node
+        delete(handles, node)
+        if node == to {
+            path := []N{to}
+            for node != from {
+                node = previous[node]
+                path = append(path, node)
+            }
+            return Reversed(path), distance[to], nil
+        }
+        for neighbor, weight := range g.neighbors(node).All() {
+            if weight < 0 {
+                return nil, 0, errors.New("Negative edge weights are not supported")
+            }
+            candidate := distance[node] + weight
+            if known, found := distance[neighbor]; found && candidate >= known { continue }
+            distance[neighbor] = candidate
+            previous[neighbor] = node
+            next := graphItem[N]{neighbor, candidate + heuristic(neighbor)}
+            if handle, queued := handles[neighbor]; queued {
+                pq.Update(handle, next)
+            } else {
+                handles[neighbor] = pq.Push(next)
+            }
+        }
+    }
+    return nil, 0, errors.New("No path between the nodes")
+}
+
+// Returns a minimum spanning forest of the graph and its total weight using Prim's algorithm.
+// The forest contains every node and spans each connected component.
+// Raises error for directed graphs.
+func (g *Graph[N]) MinimumSpanningTree() (*Graph[N], float64, error) {
+    if g.directed {
+        return nil, 0, errors.New("Minimum spanning tree needs an undirected graph")
+    }
+    tree := NewGraph[N](false)
+    total := 0.0
+    pq := NewPriorityQueue(func(a, b Edge[N]) int { return compareOrdered(a.Weight, b.Weight) })
+    for _, root := range g.Nodes() {
+        if tree.HasNode(root) { continue }
+        tree.AddNode(root)
+        pushEdges := func(node N) {
+            for neighbor, weight := range g.neighbors(node).All() {
+                if !tree.HasNode(neighbor) {
+                    pq.Push(Edge[N]{node, neighbor, weight})
+                }
+            }
+        }
+        pushEdges(root)
+        for pq.Len() > 0 {
+            edge, _ := pq.Pop()
+            if tree.HasNode(edge.To) { continue }
+            tree.AddWeightedEdge(edge.From, edge.To, edge.Weight)
+            total += edge.Weight
+            pushEdges(edge.To)
+        }
+    }
+    return tree, total, nil
+}
+
+func (g *Graph[N]) neighbors(node N) *OrderedMap[N, float64] {
+    neighbors, _ := g.adjacency.Get(node)
+    return neighbors
+}
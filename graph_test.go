@@ -0,0 +1,146 @@
+package gollections_test
+
+import (
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for graph", func() {
+
+    edges := func(pairs ...string) []*Pair[string, string] {
+        result := []*Pair[string, string]{}
+        for i := 0; i < len(pairs); i += 2 {
+            result = append(result, &Pair[string, string]{pairs[i], pairs[i + 1]})
+        }
+        return result
+    }
+
+    Context("Building the graph", func() {
+        It("Should keep nodes and edges in insertion order", func() {
+            directed := GraphFromEdges(edges("a", "b", "a", "c", "c", "b"), true)
+            Expect(directed.Nodes()).Should(Equal([]string{"a", "b", "c"}))
+            Expect(directed.Neighbors("a")).Should(Equal([]string{"b", "c"}))
+            Expect(directed.HasEdge("b", "a")).Should(BeFalse())
+            Expect(directed.Edges()).Should(HaveLen(3))
+
+            undirected := NewGraph[string](false)
+            undirected.AddWeightedEdge("a", "b", 2.5)
+            weight, found := undirected.Weight("b", "a")
+            Expect(weight).Should(Equal(2.5))
+            Expect(found).Should(BeTrue())
+            Expect(undirected.Edges()).Should(Equal([]Edge[string]{{"a", "b", 2.5}}))
+            Expect(undirected.RemoveEdge("b", "a")).Should(BeTrue())
+            Expect(undirected.HasEdge("a", "b")).Should(BeFalse())
+            Expect(undirected.RemoveEdge("a", "b")).Should(BeFalse())
+            Expect(undirected.Neighbors("z")).Should(Equal([]string{}))
+        })
+    })
+
+    Context("BFS() and DFS()", func() {
+        It("Should traverse reachable nodes lazily", func() {
+            graph := GraphFromEdges(edges("a", "b", "a", "c", "b", "d", "c", "d", "d", "e", "f", "a"), true)
+            Expect(graph.BFS("a").Collect()).Should(Equal([]string{"a", "b", "c", "d", "e"}))
+            Expect(graph.DFS("a").Collect()).Should(Equal([]string{"a", "b", "d", "e", "c"}))
+            Expect(graph.BFS("a").Take(2).Collect()).Should(Equal([]string{"a", "b"}))
+            Expect(graph.DFS("missing").Collect()).Should(Equal([]string{}))
+        })
+    })
+
+    Context("Components", func() {
+        It("Should find connected and strongly connected components", func() {
+            graph := GraphFromEdges(edges("a", "b", "b", "c", "c", "a", "c", "d", "e", "f"), true)
+            Expect(graph.ConnectedComponents()).Should(Equal([][]string{{"a", "b", "c", "d"}, {"e", "f"}}))
+            Expect(graph.StronglyConnectedComponents()).Should(Equal([][]string{{"d"}, {"c", "b", "a"}, {"f"}, {"e"}}))
+        })
+    })
+
+    Context("HasCycle()", func() {
+        It("Should detect cycles in directed and undirected graphs", func() {
+            Expect(GraphFromEdges(edges("a", "b", "b", "c", "a", "c"), true).HasCycle()).Should(BeFalse())
+            Expect(GraphFromEdges(edges("a", "b", "b", "c", "c", "a"), true).HasCycle()).Should(BeTrue())
+            Expect(GraphFromEdges(edges("a", "b", "b", "c", "c", "d"), false).HasCycle()).Should(BeFalse())
+            Expect(GraphFromEdges(edges("a", "b", "b", "c", "a", "c"), false).HasCycle()).Should(BeTrue())
+            Expect(GraphFromEdges(edges("a", "a"), false).HasCycle()).Should(BeTrue())
+        })
+    })
+
+    Context("ShortestPath() and AStar()", func() {
+        It("Should find the lightest path", func() {
+            graph := NewGraph[string](true)
+            graph.AddWeightedEdge("a", "b", 4)
+            graph.AddWeightedEdge("a", "c", 1)
+            graph.AddWeightedEdge("c", "b", 2)
+            graph.AddWeightedEdge("b", "d", 1)
+            graph.AddNode("e")
+            path, weight, err := graph.ShortestPath("a", "d")
+            Expect(err).Should(BeNil())
+            Expect(path).Should(Equal([]string{"a", "c", "b", "d"}))
+            Expect(weight).Should(Equal(4.0))
+            path, weight, err = graph.ShortestPath("a", "a")
+            Expect(path).Should(Equal([]string{"a"}))
+            Expect(weight).Should(Equal(0.0))
+            _, _, err = graph.ShortestPath("a", "e")
+            Expect(err.Error()).Should(Equal("No path between the nodes"))
+            _, _, err = graph.ShortestPath("a", "z")
+            Expect(err.Error()).Should(Equal("Node is not in the graph"))
+            graph.AddWeightedEdge("a", "e", -1)
+            _, _, err = graph.ShortestPath("a", "e")
+            Expect(err.Error()).Should(Equal("Negative edge weights are not supported"))
+        })
+
+        It("Should use the heuristic on a grid", func() {
+            type cell struct{ x, y int }
+            wall := func(c cell) bool { return c.x == 2 && c.y < 4 }
+            grid := NewGraph[cell](false)
+            for x := 0; x < 5; x++ {
+                for y := 0; y < 5; y++ {
+                    if wall(cell{x, y}) { continue }
+                    if x < 4 && !wall(cell{x + 1, y}) { grid.AddEdge(cell{x, y}, cell{x + 1, y}) }
+                    if y < 4 && !wall(cell{x, y + 1}) { grid.AddEdge(cell{x, y}, cell{x, y + 1}) }
+                }
+            }
+            manhattan := func(c cell) float64 { return float64(4 - c.x + c.y) }
+            path, weight, err := grid.AStar(cell{0, 0}, cell{4, 0}, manhattan)
+            Expect(err).Should(BeNil())
+            Expect(weight).Should(Equal(12.0))
+            Expect(path).Should(HaveLen(13))
+            _, dijkstra, _ := grid.ShortestPath(cell{0, 0}, cell{4, 0})
+            Expect(dijkstra).Should(Equal(weight))
+        })
+
+        It("Should reopen nodes when the heuristic is admissible but not consistent", func() {
+            graph := NewGraph[string](true)
+            graph.AddWeightedEdge("S", "A", 1)
+            graph.AddWeightedEdge("S", "B", 1)
+            graph.AddWeightedEdge("A", "C", 3)
+            graph.AddWeightedEdge("B", "C", 1)
+            graph.AddWeightedEdge("C", "G", 3)
+            heuristic := func(node string) float64 {
+                if node == "B" { return 3.5 }
+                return 0
+            }
+            path, weight, err := graph.AStar("S", "G", heuristic)
+            Expect(err).Should(BeNil())
+            Expect(path).Should(Equal([]string{"S", "B", "C", "G"}))
+            Expect(weight).Should(Equal(5.0))
+        })
+    })
+
+    Context("MinimumSpanningTree()", func() {
+        It("Should span every component with the lightest edges", func() {
+            graph := NewGraph[string](false)
+            graph.AddWeightedEdge("a", "b", 1)
+            graph.AddWeightedEdge("b", "c", 2)
+            graph.AddWeightedEdge("a", "c", 3)
+            graph.AddWeightedEdge("c", "d", 1)
+            graph.AddWeightedEdge("e", "f", 5)
+            tree, total, err := graph.MinimumSpanningTree()
+            Expect(err).Should(BeNil())
+            Expect(total).Should(Equal(9.0))
+            Expect(tree.Nodes()).Should(HaveLen(6))
+            Expect(tree.HasEdge("a", "c")).Should(BeFalse())
+            Expect(tree.Edges()).Should(HaveLen(4))
+            _, _, err = NewGraph[string](true).MinimumSpanningTree()
+            Expect(err).ShouldNot(BeNil())
+        })
+    })
+})
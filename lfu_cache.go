@@ -0,0 +1,135 @@
+package gollections
+
+import "time"
+
+// Cache that evicts the least frequently used entry when full,
+// breaking ties by evicting the least recently used one.
+// All operations are O(1). It is not safe for concurrent use, see SyncCache.
+type LFUCache[K comparable, V any] struct {
+    cacheCore[K, V]
+    entries map[K]*lfuEntry[K, V]
+    // Sentinel of the bucket list, sorted by ascending frequency
+    root lfuBucket[K, V]
+}
+
+type lfuEntry[K comparable, V any] struct {
+    cacheEntry[V]
+    bucket *lfuBucket[K, V]
+}
+
+// Keys sharing the same use count, from least to most recently used
+type lfuBucket[K comparable, V any] struct {
+    freq int
+    keys *OrderedMap[K, struct{}]
+    prev, next *lfuBucket[K, V]
+}
+
+// Returns an empty LFU cache holding at most capacity entries.
+// A capacity less than 1 is treated as 1.
+func NewLFUCache[K comparable, V any] (capacity int) *LFUCache[K, V] {
+    cache := &LFUCache[K, V]{
+        cacheCore: cacheCore[K, V]{capacity: max(capacity, 1)},
+        entries: make(map[K]*lfuEntry[K, V]),
+    }
+    cache.root.prev, cache.root.next = &cache.root, &cache.root
+    return cache
+}
+
+// Returns the value of the key and counts it as a use
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+    value, found := c.Peek(key)
+    c.record(found)
+    if found {
+        c.touch(key, c.entries[key])
+    }
+    return value, found
+}
+
+// Returns the value of the key without counting it as a use
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+    entry, found := c.entries[key]
+    if !found { return zero[V](), false }
+    if c.expired(entry.cacheEntry) {
+        c.unlink(key, entry)
+        c.evicted(key, entry.value, true)
+        return zero[V](), false
+    }
+    return entry.value, true
+}
+
+// Sets the value of the key using the default TTL of the cache
+func (c *LFUCache[K, V]) Put(key K, value V) {
+    c.PutWithTTL(key, value, c.ttl)
+}
+
+// Sets the value of the key, expiring after ttl, and counts it as a use.
+// Evicts the least frequently used entry if the cache is full.
+func (c *LFUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+    if entry, found := c.entries[key]; found {
+        entry.cacheEntry = c.entry(value, ttl)
+        c.touch(key, entry)
+        return
+    }
+    if len(c.entries) >= c.capacity {
+        victim := c.root.next.keys.front().key
+        entry := c.entries[victim]
+        c.unlink(victim, entry)
+        c.evicted(victim, entry.value, c.expired(entry.cacheEntry))
+    }
+    entry := &lfuEntry[K, V]{cacheEntry: c.entry(value, ttl)}
+    c.entries[key] = entry
+    c.moveTo(key, entry, &c.root, 1)
+}
+
+// Removes the key, returns whether it was there
+func (c *LFUCache[K, V]) Remove(key K) bool {
+    entry, found := c.entries[key]
+    if !found { return false }
+    c.unlink(key, entry)
+    return true
+}
+
+// Returns the number of entries in the cache, including expired ones not yet removed
+func (c *LFUCache[K, V]) Len() int {
+    return len(c.entries)
+}
+
+// Returns how many times the key has been used, 0 if it is not there
+func (c *LFUCache[K, V]) Frequency(key K) int {
+    if entry, found := c.entries[key]; found {
+        return entry.bucket.freq
+    }
+    return 0
+}
+
+func (c *LFUCache[K, V]) touch(key K, entry *lfuEntry[K, V]) {
+    bucket := entry.bucket
+    c.moveTo(key, entry, bucket, bucket.freq + 1)
+    c.removeFromBucket(key, bucket)
+}
+
+// Puts the key in the bucket with the given frequency right after `after`,
+// creating that bucket if needed
+func (c *LFUCache[K, V]) moveTo(key K, entry *lfuEntry[K, V], after *lfuBucket[K, V], freq int) {
+    bucket := after.next
+    if bucket == &c.root || bucket.freq != freq {
+        bucket = &lfuBucket[K, V]{freq: freq, keys: NewOrderedMap[K, struct{}](), prev: after, next: after.next}
+        after.next.prev = bucket
+        after.next = bucket
+    }
+    bucket.keys.Set(key, struct{}{})
+    entry.bucket = bucket
+}
+
+func (c *LFUCache[K, V]) unlink(key K, entry *lfuEntry[K, V]) {
+    delete(c.entries, key)
+    c.removeFromBucket(key, entry.bucket)
+}
+
+func (c *LFUCache[K, V]) removeFromBucket(key K, bucket *lfuBucket[K, V]) {
+    bucket.keys.Delete(key)
+    if bucket.keys.Len() == 0 {
+        bucket.prev.next = bucket.next
+        bucket.next.prev = bucket.prev
+    }
+}
@@ -0,0 +1,74 @@
+package gollections_test
+
+import (
+	"time"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for LFU cache", func() {
+
+    var cache *LFUCache[string, int]
+    var evicted []string
+
+    BeforeEach(func() {
+        evicted = []string{}
+        cache = NewLFUCache[string, int](2)
+        cache.SetOnEvict(func(key string, value int) { evicted = append(evicted, key) })
+    })
+
+    Context("Get() and Put()", func() {
+        It("Should evict the least frequently used entry", func() {
+            cache.Put("a", 1)
+            cache.Put("b", 2)
+            cache.Get("a")
+            cache.Get("a")
+            cache.Get("b")
+            Expect(cache.Frequency("a")).Should(Equal(3))
+            Expect(cache.Frequency("b")).Should(Equal(2))
+            cache.Put("c", 3)
+            Expect(evicted).Should(Equal([]string{"b"}))
+            cache.Put("d", 4)
+            Expect(evicted).Should(Equal([]string{"b", "c"}))
+            Expect(cache.Frequency("c")).Should(Equal(0))
+            Expect(cache.Stats()).Should(Equal(CacheStats{Hits: 3, Evictions: 2}))
+        })
+
+        It("Should break ties by recency", func() {
+            cache.Put("a", 1)
+            cache.Put("b", 2)
+            cache.Put("a", 10)
+            cache.Put("b", 20)
+            cache.Put("c", 3)
+            Expect(evicted).Should(Equal([]string{"a"}))
+            value, _ := cache.Peek("b")
+            Expect(value).Should(Equal(20))
+            Expect(cache.Frequency("b")).Should(Equal(2))
+        })
+
+        It("Should remove entries", func() {
+            cache.Put("a", 1)
+            cache.Get("a")
+            Expect(cache.Remove("a")).Should(BeTrue())
+            Expect(cache.Remove("a")).Should(BeFalse())
+            cache.Put("b", 2)
+            cache.Put("c", 3)
+            cache.Put("d", 4)
+            Expect(evicted).Should(Equal([]string{"b"}))
+            Expect(cache.Len()).Should(Equal(2))
+        })
+    })
+
+    Context("TTL", func() {
+        It("Should expire entries using the injected clock", func() {
+            clock := NewManualClock(time.Unix(0, 0))
+            cache.SetClock(clock)
+            cache.PutWithTTL("a", 1, time.Second)
+            clock.Advance(time.Second)
+            _, found := cache.Get("a")
+            Expect(found).Should(BeFalse())
+            Expect(cache.Len()).Should(Equal(0))
+            Expect(cache.Stats()).Should(Equal(CacheStats{Misses: 1, Expirations: 1}))
+        })
+    })
+})
@@ -1,6 +1,7 @@
 package gollections
 
 import (
+	"cmp"
 	"errors"
 
 	"golang.org/x/exp/constraints"
@@ -8,14 +9,7 @@ import (
 
 // Filters the slice based on the given predicate
 func Filter[T any] (slice []T, predicate func(T) bool) []T {
-    var filteredSlice = []T{}
-    if predicate == nil { return filteredSlice }
-    for _, elem := range slice {
-        if predicate(elem) {
-            filteredSlice = append(filteredSlice, elem)
-        }
-    }
-    return filteredSlice
+    return Lazy(slice).Filter(predicate).Collect()
 }
 
 // Filters the slice based on the given predicate
@@ -34,12 +28,7 @@ func FilterIndexed[T any] (slice []T, predicate func(int, T) bool) []T {
 // Applies the transform function on each element of the slice 
 // and returns a slice of the transformed value
 func Map[T any, R any] (slice []T, transform func(T) R) []R {
-    var transformedSlice = []R{}
-    if transform == nil { return transformedSlice }
-    for _, elem := range slice {
-        transformedSlice = append(transformedSlice, transform(elem))
-    }
-    return transformedSlice 
+    return MapSeq(Lazy(slice), transform).Collect()
 }
 
 // Applies the transform function on each element of the slice 
@@ -57,13 +46,21 @@ func MapIndexed[T any, R any] (slice []T, transform func(int, T) R) []R {
 //Returns true if all elements satisfy the given predicate
 func All[T any] (slice []T, predicate func(T) bool) bool {
     if predicate == nil || len(slice) == 0 { return false }
-    return len(Filter(slice, predicate)) == len(slice)
+    _, err := Lazy(slice).Filter(Not(predicate)).First()
+    return err != nil
 } 
 
 //Returns true if any one of elements satisfy the given predicate
 func Any[T any] (slice []T, predicate func(T) bool) bool {
     if predicate == nil || len(slice) == 0 { return false }
-    return len(Filter(slice, predicate)) > 0
+    _, err := Lazy(slice).Filter(predicate).First()
+    return err == nil
+}
+
+// Returns true if none of the elements satisfy the given predicate
+func None[T any] (slice []T, predicate func(T) bool) bool {
+    if predicate == nil { return false }
+    return !Any(slice, predicate)
 }
 
 // Returns a map generated from the given slice, using the given transform
@@ -79,7 +76,7 @@ func Associate[T any, K comparable, V any] (slice []T, transform func(T) (K, V))
 
 // Returns whether the given slice contains the given target value
 func Contains[T comparable] (slice []T, target T) bool {
-    return len(Filter(slice, func(x T) bool { return x == target })) > 0
+    return IndexOf(slice, target) >= 0
 }
 
 // Drops the value from the given slice at index and returns.
@@ -97,8 +94,8 @@ func Drop[T any] (slice []T, index int) []T {
 // Raises error if there is no such element
 func First[T any] (slice []T, predicate func(T) bool) (T, error) {
     if predicate == nil { return zero[T](), errors.New("nil function pointer passed")}
-    if filtered := Filter(slice, predicate); len(filtered) > 0 {
-        return filtered[0], nil
+    if first, err := Lazy(slice).Filter(predicate).First(); err == nil {
+        return first, nil
     }
     return zero[T](), errors.New("No element found satisfying predicate")
 }
@@ -268,16 +265,12 @@ func MinOfBy[T any] (slice []T, comparer func(T, T) int) (T, error) {
 func Partition[T any] (slice []T, predicate func(T) bool) ([]T, []T) {
     if predicate == nil { return []T{}, []T{} }
 
-    return Filter(slice, predicate), Filter(slice, func(x T) bool { return !predicate(x) })
+    return Filter(slice, predicate), Filter(slice, Not(predicate))
 }
 
 // Returns the elements in reversed oreder
 func Reversed[T any] (slice []T) []T {
-    reversed := []T{}
-    for i := len(slice) - 1; i >= 0; i-- {
-        reversed = append(reversed, slice[i])
-    }
-    return reversed
+    return LazyReversed(slice).Collect()
 } 
 
 // Returns subarray of the slice from `from` upto `to` indecies.
@@ -295,11 +288,7 @@ func SubList[T any] (slice []T, from, to int) []T {
 
 // Returns a slice of pointers of Pair zipping given slices.
 func Zip[T, R any] (a []T, b []R) []*Pair[T, R] {
-    zip := []*Pair[T, R]{}
-    for i := 0; i < min(len(a), len(b)); i++ {
-        zip = append(zip, &Pair[T, R]{a[i], b[i]})
-    }
-    return zip
+    return ZipWith(a, b, func(first T, second R) *Pair[T, R] { return &Pair[T, R]{first, second} })
 }
 
 func max[T constraints.Ordered] (a, b T) T {
@@ -321,3 +310,8 @@ func zero[T any]() T {
     var v T
     return v
 }
+
+// Orders NaN before every other float and equal to itself, so floats sort consistently
+func compareOrdered[T constraints.Ordered] (a, b T) int {
+    return cmp.Compare(a, b)
+}
@@ -0,0 +1,118 @@
+package gollections
+
+import "context"
+
+// Filters the slice based on the given predicate.
+// Checks ctx between elements and on cancellation returns
+// the elements filtered so far along with ctx.Err().
+func FilterCtx[T any] (ctx context.Context, slice []T, predicate func(T) bool) ([]T, error) {
+    filteredSlice := []T{}
+    if predicate == nil { return filteredSlice, nil }
+    err := forEachCtx(ctx, slice, func(elem T) {
+        if predicate(elem) {
+            filteredSlice = append(filteredSlice, elem)
+        }
+    })
+    return filteredSlice, err
+}
+
+// Applies the transform function on each element of the slice.
+// Checks ctx between elements and on cancellation returns
+// the values transformed so far along with ctx.Err().
+func MapCtx[T any, R any] (ctx context.Context, slice []T, transform func(T) R) ([]R, error) {
+    transformedSlice := []R{}
+    if transform == nil { return transformedSlice, nil }
+    err := forEachCtx(ctx, slice, func(elem T) {
+        transformedSlice = append(transformedSlice, transform(elem))
+    })
+    return transformedSlice, err
+}
+
+// Accumulates value starting with the given initial
+// by performing operation on the slice from left to right.
+// Checks ctx between elements and on cancellation returns
+// the value accumulated so far along with ctx.Err().
+func FoldCtx[T any, R any] (ctx context.Context, slice []T, initial R, operation func(T, R) R) (R, error) {
+    accumulator := initial
+    if operation == nil { return accumulator, nil }
+    err := forEachCtx(ctx, slice, func(elem T) {
+        accumulator = operation(elem, accumulator)
+    })
+    return accumulator, err
+}
+
+// Performs the given operation for each element in the slice.
+// Checks ctx between elements and returns ctx.Err() on cancellation.
+func ForEachCtx[T any] (ctx context.Context, slice []T, operation func(T)) error {
+    if operation == nil { return nil }
+    return forEachCtx(ctx, slice, operation)
+}
+
+// Same as ParallelMap but stops starting new elements once ctx is done.
+// On cancellation ctx.Err() is returned and the values of
+// the elements that were never processed are left as zero values.
+func ParallelMapCtx[T any, R any] (ctx context.Context, slice []T, transform func(T) R, concurrency int) ([]R, error) {
+    if transform == nil { return []R{}, nil }
+    transformedSlice := make([]R, len(slice))
+    err := runParallelCtx(ctx, len(slice), concurrency, func(i int) {
+        transformedSlice[i] = transform(slice[i])
+    })
+    return transformedSlice, err
+}
+
+// Same as ParallelFilter but stops starting new elements once ctx is done.
+// On cancellation ctx.Err() is returned along with
+// the processed elements that satisfied the predicate.
+func ParallelFilterCtx[T any] (ctx context.Context, slice []T, predicate func(T) bool, concurrency int) ([]T, error) {
+    filteredSlice := []T{}
+    if predicate == nil { return filteredSlice, nil }
+    keep := make([]bool, len(slice))
+    err := runParallelCtx(ctx, len(slice), concurrency, func(i int) {
+        keep[i] = predicate(slice[i])
+    })
+    for i, elem := range slice {
+        if keep[i] {
+            filteredSlice = append(filteredSlice, elem)
+        }
+    }
+    return filteredSlice, err
+}
+
+// Same as ParallelForEach but stops starting new elements once ctx is done
+// and returns ctx.Err() on cancellation.
+func ParallelForEachCtx[T any] (ctx context.Context, slice []T, operation func(T), concurrency int) error {
+    if operation == nil { return nil }
+    return runParallelCtx(ctx, len(slice), concurrency, func(i int) {
+        operation(slice[i])
+    })
+}
+
+// Same as ParallelFold but every chunk checks ctx between elements.
+// On cancellation ctx.Err() is returned along with
+// the combination of whatever the chunks accumulated so far.
+func ParallelFoldCtx[T any, R any] (ctx context.Context, slice []T, initial R, operation func(T, R) R, combiner func(R, R) R, concurrency int) (R, error) {
+    if operation == nil || combiner == nil || len(slice) == 0 { return initial, nil }
+    chunks := workerCount(len(slice), concurrency)
+    partials := make([]R, chunks)
+    errs := make([]error, chunks)
+    runParallel(chunks, chunks, func(c int) {
+        from, to := c * len(slice) / chunks, (c + 1) * len(slice) / chunks
+        partials[c], errs[c] = FoldCtx(ctx, slice[from:to], initial, operation)
+    })
+    accumulator := partials[0]
+    for _, partial := range partials[1:] {
+        accumulator = combiner(accumulator, partial)
+    }
+    for _, err := range errs {
+        if err != nil { return accumulator, err }
+    }
+    return accumulator, nil
+}
+
+func forEachCtx[T any] (ctx context.Context, slice []T, operation func(T)) error {
+    for _, elem := range slice {
+        if err := ctx.Err(); err != nil { return err }
+        operation(elem)
+    }
+    return nil
+}
@@ -0,0 +1,97 @@
+package gollections_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for context aware list utilities", func() {
+
+    var list []int
+
+    BeforeEach(func() {
+        list = []int{1, 2, 3, 4, 5}
+    })
+
+    Context("MapCtx()", func() {
+        It("Should return the partial result once the context is cancelled", func() {
+            ctx, cancel := context.WithCancel(context.Background())
+            values, err := MapCtx(ctx, list, func(x int) int {
+                if x == 3 { cancel() }
+                return x * x
+            })
+            Expect(values).Should(Equal([]int{1, 4, 9}))
+            Expect(errors.Is(err, context.Canceled)).Should(BeTrue())
+
+            values, err = MapCtx(context.Background(), list, func(x int) int { return x * x })
+            Expect(values).Should(Equal([]int{1, 4, 9, 16, 25}))
+            Expect(err).Should(BeNil())
+        })
+    })
+
+    Context("FilterCtx()", func() {
+        It("Should filter until the context is cancelled", func() {
+            ctx, cancel := context.WithCancel(context.Background())
+            filtered, err := FilterCtx(ctx, list, func(x int) bool {
+                if x == 4 { cancel() }
+                return x % 2 == 0
+            })
+            Expect(filtered).Should(Equal([]int{2, 4}))
+            Expect(err).Should(Equal(context.Canceled))
+        })
+    })
+
+    Context("FoldCtx() and ForEachCtx()", func() {
+        It("Should not run anything on an already cancelled context", func() {
+            ctx, cancel := context.WithCancel(context.Background())
+            cancel()
+            sum, err := FoldCtx(ctx, list, 10, func(a, b int) int { return a + b })
+            Expect(sum).Should(Equal(10))
+            Expect(err).Should(Equal(context.Canceled))
+
+            visited := 0
+            Expect(ForEachCtx(ctx, list, func(x int) { visited++ })).Should(Equal(context.Canceled))
+            Expect(visited).Should(Equal(0))
+
+            Expect(ForEachCtx(context.Background(), list, func(x int) { visited++ })).Should(BeNil())
+            Expect(visited).Should(Equal(5))
+        })
+    })
+
+    Context("Parallel context variants", func() {
+        It("Should behave like the parallel variants on a live context", func() {
+            ctx := context.Background()
+            square := func(x int) int { return x * x }
+            values, err := ParallelMapCtx(ctx, list, square, 2)
+            Expect(values).Should(Equal([]int{1, 4, 9, 16, 25}))
+            Expect(err).Should(BeNil())
+
+            filtered, err := ParallelFilterCtx(ctx, list, func(x int) bool { return x > 2 }, 2)
+            Expect(filtered).Should(Equal([]int{3, 4, 5}))
+            Expect(err).Should(BeNil())
+
+            add := func(a, b int) int { return a + b }
+            sum, err := ParallelFoldCtx(ctx, list, 0, add, add, 2)
+            Expect(sum).Should(Equal(15))
+            Expect(err).Should(BeNil())
+        })
+
+        It("Should stop on a cancelled context", func() {
+            ctx, cancel := context.WithCancel(context.Background())
+            cancel()
+            visited := 0
+            Expect(ParallelForEachCtx(ctx, list, func(x int) { visited++ }, 1)).Should(Equal(context.Canceled))
+            Expect(visited).Should(Equal(0))
+
+            values, err := ParallelMapCtx(ctx, list, func(x int) int { return x }, 2)
+            Expect(values).Should(Equal([]int{0, 0, 0, 0, 0}))
+            Expect(err).Should(Equal(context.Canceled))
+
+            add := func(a, b int) int { return a + b }
+            _, err = ParallelFoldCtx(ctx, list, 0, add, add, 2)
+            Expect(err).Should(Equal(context.Canceled))
+        })
+    })
+})
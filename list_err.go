@@ -0,0 +1,250 @@
+package gollections
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error returned by a callback, wrapped with the index of the element it failed on
+type IndexError struct {
+    Index int
+    Err error
+}
+
+func (e *IndexError) Error() string {
+    return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+    return e.Err
+}
+
+// Filters the slice based on the given fallible predicate.
+// Stops at the first error and returns it as an *IndexError
+// along with the elements filtered so far.
+func FilterErr[T any] (slice []T, predicate func(T) (bool, error)) ([]T, error) {
+    if predicate == nil { return []T{}, nil }
+    return filterErr(slice, false, func(_ int, elem T) (bool, error) { return predicate(elem) })
+}
+
+// Filters the slice based on the given fallible predicate.
+// Elements whose predicate fails are left out and
+// every failure is returned joined with errors.Join.
+func FilterErrAll[T any] (slice []T, predicate func(T) (bool, error)) ([]T, error) {
+    if predicate == nil { return []T{}, nil }
+    return filterErr(slice, true, func(_ int, elem T) (bool, error) { return predicate(elem) })
+}
+
+// Filters the slice based on the given fallible predicate.
+// The predicate also considers index of each element.
+// Stops at the first error and returns it as an *IndexError.
+func FilterIndexedErr[T any] (slice []T, predicate func(int, T) (bool, error)) ([]T, error) {
+    if predicate == nil { return []T{}, nil }
+    return filterErr(slice, false, predicate)
+}
+
+// Filters the slice based on the given fallible predicate.
+// The predicate also considers index of each element.
+// Every failure is returned joined with errors.Join.
+func FilterIndexedErrAll[T any] (slice []T, predicate func(int, T) (bool, error)) ([]T, error) {
+    if predicate == nil { return []T{}, nil }
+    return filterErr(slice, true, predicate)
+}
+
+// Applies the fallible transform function on each element of the slice.
+// Stops at the first error and returns it as an *IndexError
+// along with the values transformed so far.
+func MapErr[T any, R any] (slice []T, transform func(T) (R, error)) ([]R, error) {
+    if transform == nil { return []R{}, nil }
+    return mapErr(slice, false, func(_ int, elem T) (R, error) { return transform(elem) })
+}
+
+// Applies the fallible transform function on each element of the slice.
+// Elements whose transform fails are left out and
+// every failure is returned joined with errors.Join.
+func MapErrAll[T any, R any] (slice []T, transform func(T) (R, error)) ([]R, error) {
+    if transform == nil { return []R{}, nil }
+    return mapErr(slice, true, func(_ int, elem T) (R, error) { return transform(elem) })
+}
+
+// Applies the fallible transform function on each element of the slice.
+// The transform also considers index of each element.
+// Stops at the first error and returns it as an *IndexError.
+func MapIndexedErr[T any, R any] (slice []T, transform func(int, T) (R, error)) ([]R, error) {
+    if transform == nil { return []R{}, nil }
+    return mapErr(slice, false, transform)
+}
+
+// Applies the fallible transform function on each element of the slice.
+// The transform also considers index of each element.
+// Every failure is returned joined with errors.Join.
+func MapIndexedErrAll[T any, R any] (slice []T, transform func(int, T) (R, error)) ([]R, error) {
+    if transform == nil { return []R{}, nil }
+    return mapErr(slice, true, transform)
+}
+
+// Accumulates value starting with the given initial
+// by performing the fallible operation on the slice from left to right.
+// Stops at the first error and returns it as an *IndexError
+// along with the value accumulated so far.
+func FoldErr[T any, R any] (slice []T, initial R, operation func(T, R) (R, error)) (R, error) {
+    if operation == nil { return initial, nil }
+    return foldErr(slice, initial, false, func(_ int, elem T, acc R) (R, error) { return operation(elem, acc) })
+}
+
+// Accumulates value starting with the given initial
+// by performing the fallible operation on the slice from left to right.
+// Elements whose operation fails leave the accumulator unchanged and
+// every failure is returned joined with errors.Join.
+func FoldErrAll[T any, R any] (slice []T, initial R, operation func(T, R) (R, error)) (R, error) {
+    if operation == nil { return initial, nil }
+    return foldErr(slice, initial, true, func(_ int, elem T, acc R) (R, error) { return operation(elem, acc) })
+}
+
+// Accumulates value starting with the given initial
+// by performing the fallible operation on the slice from left to right.
+// The operation also considers index of each element.
+// Stops at the first error and returns it as an *IndexError.
+func FoldIndexedErr[T any, R any] (slice []T, initial R, operation func(int, T, R) (R, error)) (R, error) {
+    if operation == nil { return initial, nil }
+    return foldErr(slice, initial, false, operation)
+}
+
+// Accumulates value starting with the given initial
+// by performing the fallible operation on the slice from left to right.
+// The operation also considers index of each element.
+// Every failure is returned joined with errors.Join.
+func FoldIndexedErrAll[T any, R any] (slice []T, initial R, operation func(int, T, R) (R, error)) (R, error) {
+    if operation == nil { return initial, nil }
+    return foldErr(slice, initial, true, operation)
+}
+
+// Performs the given fallible operation for each element in the slice.
+// Stops at the first error and returns it as an *IndexError.
+func ForEachErr[T any] (slice []T, operation func(T) error) error {
+    if operation == nil { return nil }
+    return tryEach(slice, false, func(_ int, elem T) error { return operation(elem) })
+}
+
+// Performs the given fallible operation for each element in the slice.
+// Every failure is returned joined with errors.Join.
+func ForEachErrAll[T any] (slice []T, operation func(T) error) error {
+    if operation == nil { return nil }
+    return tryEach(slice, true, func(_ int, elem T) error { return operation(elem) })
+}
+
+// Performs the given fallible operation for each element in the slice.
+// The operation also considers index of each element.
+// Stops at the first error and returns it as an *IndexError.
+func ForEachIndexedErr[T any] (slice []T, operation func(int, T) error) error {
+    if operation == nil { return nil }
+    return tryEach(slice, false, operation)
+}
+
+// Performs the given fallible operation for each element in the slice.
+// The operation also considers index of each element.
+// Every failure is returned joined with errors.Join.
+func ForEachIndexedErrAll[T any] (slice []T, operation func(int, T) error) error {
+    if operation == nil { return nil }
+    return tryEach(slice, true, operation)
+}
+
+// Returns a map generated from the given slice, using the given fallible transform.
+// Stops at the first error and returns it as an *IndexError.
+func AssociateErr[T any, K comparable, V any] (slice []T, transform func(T) (K, V, error)) (map[K]V, error) {
+    return associateErr(slice, false, transform)
+}
+
+// Returns a map generated from the given slice, using the given fallible transform.
+// Every failure is returned joined with errors.Join.
+func AssociateErrAll[T any, K comparable, V any] (slice []T, transform func(T) (K, V, error)) (map[K]V, error) {
+    return associateErr(slice, true, transform)
+}
+
+// Groups the slice by the given fallible selector.
+// Stops at the first error and returns it as an *IndexError.
+func GroupByErr[T any, K comparable] (slice []T, selector func(T) (K, error)) (map[K][]T, error) {
+    return groupByErr(slice, false, selector)
+}
+
+// Groups the slice by the given fallible selector.
+// Every failure is returned joined with errors.Join.
+func GroupByErrAll[T any, K comparable] (slice []T, selector func(T) (K, error)) (map[K][]T, error) {
+    return groupByErr(slice, true, selector)
+}
+
+func filterErr[T any] (slice []T, collectAll bool, predicate func(int, T) (bool, error)) ([]T, error) {
+    filteredSlice := []T{}
+    err := tryEach(slice, collectAll, func(i int, elem T) error {
+        keep, err := predicate(i, elem)
+        if err == nil && keep {
+            filteredSlice = append(filteredSlice, elem)
+        }
+        return err
+    })
+    return filteredSlice, err
+}
+
+func mapErr[T any, R any] (slice []T, collectAll bool, transform func(int, T) (R, error)) ([]R, error) {
+    transformedSlice := []R{}
+    err := tryEach(slice, collectAll, func(i int, elem T) error {
+        value, err := transform(i, elem)
+        if err == nil {
+            transformedSlice = append(transformedSlice, value)
+        }
+        return err
+    })
+    return transformedSlice, err
+}
+
+func foldErr[T any, R any] (slice []T, initial R, collectAll bool, operation func(int, T, R) (R, error)) (R, error) {
+    accumulator := initial
+    err := tryEach(slice, collectAll, func(i int, elem T) error {
+        value, err := operation(i, elem, accumulator)
+        if err == nil {
+            accumulator = value
+        }
+        return err
+    })
+    return accumulator, err
+}
+
+func associateErr[T any, K comparable, V any] (slice []T, collectAll bool, transform func(T) (K, V, error)) (map[K]V, error) {
+    hashMap := make(map[K]V)
+    if transform == nil { return hashMap, nil }
+    err := tryEach(slice, collectAll, func(_ int, elem T) error {
+        key, value, err := transform(elem)
+        if err == nil {
+            hashMap[key] = value
+        }
+        return err
+    })
+    return hashMap, err
+}
+
+func groupByErr[T any, K comparable] (slice []T, collectAll bool, selector func(T) (K, error)) (map[K][]T, error) {
+    groups := make(map[K][]T)
+    if selector == nil { return groups, nil }
+    err := tryEach(slice, collectAll, func(_ int, elem T) error {
+        key, err := selector(elem)
+        if err == nil {
+            groups[key] = append(groups[key], elem)
+        }
+        return err
+    })
+    return groups, err
+}
+
+// Runs operation on each element, wrapping failures in *IndexError.
+// Returns the first failure, or all of them joined when collectAll is set.
+func tryEach[T any] (slice []T, collectAll bool, operation func(int, T) error) error {
+    var errs []error
+    for i, elem := range slice {
+        if err := operation(i, elem); err != nil {
+            err = &IndexError{i, err}
+            if !collectAll { return err }
+            errs = append(errs, err)
+        }
+    }
+    return errors.Join(errs...)
+}
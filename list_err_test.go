@@ -0,0 +1,136 @@
+package gollections_test
+
+import (
+	"errors"
+	"strconv"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for error returning list utilities", func() {
+
+    var list []string
+
+    BeforeEach(func() {
+        list = []string{"1", "2", "x", "4", "y"}
+    })
+
+    Context("MapErr()", func() {
+        It("Should stop at the first error and report its index", func() {
+            values, err := MapErr(list, strconv.Atoi)
+            Expect(values).Should(Equal([]int{1, 2}))
+            var indexErr *IndexError
+            Expect(errors.As(err, &indexErr)).Should(BeTrue())
+            Expect(indexErr.Index).Should(Equal(2))
+            Expect(errors.Is(err, strconv.ErrSyntax)).Should(BeTrue())
+
+            values, err = MapErr([]string{"1", "2"}, strconv.Atoi)
+            Expect(values).Should(Equal([]int{1, 2}))
+            Expect(err).Should(BeNil())
+
+            values, err = MapErr[string, int](list, nil)
+            Expect(values).Should(Equal([]int{}))
+            Expect(err).Should(BeNil())
+        })
+    })
+
+    Context("MapErrAll()", func() {
+        It("Should collect every error", func() {
+            values, err := MapErrAll(list, strconv.Atoi)
+            Expect(values).Should(Equal([]int{1, 2, 4}))
+            Expect(err).ShouldNot(BeNil())
+            Expect(err.(interface{ Unwrap() []error }).Unwrap()).Should(HaveLen(2))
+        })
+    })
+
+    Context("MapIndexedErr()", func() {
+        It("Should pass the index to the transform", func() {
+            values, err := MapIndexedErr([]string{"1", "2"}, func(i int, s string) (int, error) {
+                n, err := strconv.Atoi(s)
+                return n + i, err
+            })
+            Expect(values).Should(Equal([]int{1, 3}))
+            Expect(err).Should(BeNil())
+        })
+    })
+
+    Context("FilterErr()", func() {
+        It("Should filter until the first error", func() {
+            odd := func(s string) (bool, error) {
+                n, err := strconv.Atoi(s)
+                return n % 2 == 1, err
+            }
+            filtered, err := FilterErr(list, odd)
+            Expect(filtered).Should(Equal([]string{"1"}))
+            Expect(err).ShouldNot(BeNil())
+
+            filtered, err = FilterErrAll(list, odd)
+            Expect(filtered).Should(Equal([]string{"1"}))
+            Expect(err.(interface{ Unwrap() []error }).Unwrap()).Should(HaveLen(2))
+
+            filtered, err = FilterIndexedErr(list, func(i int, s string) (bool, error) { return i > 2, nil })
+            Expect(filtered).Should(Equal([]string{"4", "y"}))
+            Expect(err).Should(BeNil())
+        })
+    })
+
+    Context("FoldErr()", func() {
+        It("Should return the accumulated value and the error", func() {
+            sum := func(s string, acc int) (int, error) {
+                n, err := strconv.Atoi(s)
+                return acc + n, err
+            }
+            total, err := FoldErr(list, 0, sum)
+            Expect(total).Should(Equal(3))
+            Expect(err).ShouldNot(BeNil())
+
+            total, err = FoldErrAll(list, 0, sum)
+            Expect(total).Should(Equal(7))
+            Expect(err).ShouldNot(BeNil())
+
+            total, err = FoldErr(list, 0, nil)
+            Expect(total).Should(Equal(0))
+            Expect(err).Should(BeNil())
+        })
+    })
+
+    Context("ForEachErr()", func() {
+        It("Should stop at the first error", func() {
+            visited := 0
+            err := ForEachErr(list, func(s string) error {
+                visited++
+                _, err := strconv.Atoi(s)
+                return err
+            })
+            Expect(visited).Should(Equal(3))
+            Expect(err).ShouldNot(BeNil())
+
+            visited = 0
+            err = ForEachIndexedErrAll(list, func(i int, s string) error {
+                visited++
+                _, err := strconv.Atoi(s)
+                return err
+            })
+            Expect(visited).Should(Equal(5))
+            Expect(err.(interface{ Unwrap() []error }).Unwrap()).Should(HaveLen(2))
+        })
+    })
+
+    Context("AssociateErr() and GroupByErr()", func() {
+        It("Should build maps until the first error", func() {
+            hashMap, err := AssociateErr(list, func(s string) (string, int, error) {
+                n, err := strconv.Atoi(s)
+                return s, n, err
+            })
+            Expect(hashMap).Should(Equal(map[string]int{"1": 1, "2": 2}))
+            Expect(err).ShouldNot(BeNil())
+
+            groups, err := GroupByErrAll(list, func(s string) (int, error) {
+                n, err := strconv.Atoi(s)
+                return n % 2, err
+            })
+            Expect(groups).Should(Equal(map[int][]string{0: {"2", "4"}, 1: {"1"}}))
+            Expect(err).ShouldNot(BeNil())
+        })
+    })
+})
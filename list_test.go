@@ -62,6 +62,15 @@ var _ = Describe("Tests for list utilities", func() {
         })
     })
 
+    Context("None()", func() {
+        It("Should return true if no element satisfies predicate", func() {
+            Expect(None(list, func(x int) bool { return x < 0 })).Should(BeTrue())
+            Expect(None(list, func(x int) bool { return x % 2 == 0 })).Should(BeFalse())
+            Expect(None([]int{}, func(x int) bool { return x < 0 })).Should(BeTrue())
+            Expect(None(list, nil)).Should(BeFalse())
+        })
+    })
+
     Context("Associate()", func() {
         It("Should return a map bassed on transform", func() {
             Expect(Associate(list, func(x int) (int, string) { return x*x, "hello"})).Should(Equal(map[int]string{
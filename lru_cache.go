@@ -0,0 +1,74 @@
+package gollections
+
+import "time"
+
+// Cache that evicts the least recently used entry when full.
+// All operations are O(1). It is not safe for concurrent use, see SyncCache.
+type LRUCache[K comparable, V any] struct {
+    cacheCore[K, V]
+    // Ordered from least to most recently used
+    entries *OrderedMap[K, cacheEntry[V]]
+}
+
+// Returns an empty LRU cache holding at most capacity entries.
+// A capacity less than 1 is treated as 1.
+func NewLRUCache[K comparable, V any] (capacity int) *LRUCache[K, V] {
+    return &LRUCache[K, V]{
+        cacheCore: cacheCore[K, V]{capacity: max(capacity, 1)},
+        entries: NewOrderedMap[K, cacheEntry[V]](),
+    }
+}
+
+// Returns the value of the key and marks it as most recently used
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+    value, found := c.Peek(key)
+    c.record(found)
+    if found {
+        c.entries.MoveToBack(key)
+    }
+    return value, found
+}
+
+// Returns the value of the key without changing its recency
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+    entry, found := c.entries.Get(key)
+    if !found { return zero[V](), false }
+    if c.expired(entry) {
+        c.entries.Delete(key)
+        c.evicted(key, entry.value, true)
+        return zero[V](), false
+    }
+    return entry.value, true
+}
+
+// Sets the value of the key using the default TTL of the cache
+func (c *LRUCache[K, V]) Put(key K, value V) {
+    c.PutWithTTL(key, value, c.ttl)
+}
+
+// Sets the value of the key, expiring after ttl, and marks it as most recently used.
+// Evicts the least recently used entry if the cache is full.
+func (c *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+    if !c.entries.ContainsKey(key) && c.entries.Len() >= c.capacity {
+        oldest := c.entries.front()
+        c.entries.Delete(oldest.key)
+        c.evicted(oldest.key, oldest.value.value, c.expired(oldest.value))
+    }
+    c.entries.Set(key, c.entry(value, ttl))
+    c.entries.MoveToBack(key)
+}
+
+// Removes the key, returns whether it was there
+func (c *LRUCache[K, V]) Remove(key K) bool {
+    return c.entries.Delete(key)
+}
+
+// Returns the number of entries in the cache, including expired ones not yet removed
+func (c *LRUCache[K, V]) Len() int {
+    return c.entries.Len()
+}
+
+// Returns the keys from least to most recently used
+func (c *LRUCache[K, V]) Keys() []K {
+    return c.entries.Keys()
+}
@@ -0,0 +1,77 @@
+package gollections_test
+
+import (
+	"time"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for LRU cache", func() {
+
+    var cache *LRUCache[string, int]
+    var evicted []string
+
+    BeforeEach(func() {
+        evicted = []string{}
+        cache = NewLRUCache[string, int](2)
+        cache.SetOnEvict(func(key string, value int) { evicted = append(evicted, key) })
+    })
+
+    Context("Get() and Put()", func() {
+        It("Should evict the least recently used entry", func() {
+            cache.Put("a", 1)
+            cache.Put("b", 2)
+            value, found := cache.Get("a")
+            Expect(value).Should(Equal(1))
+            Expect(found).Should(BeTrue())
+            cache.Put("c", 3)
+            Expect(evicted).Should(Equal([]string{"b"}))
+            Expect(cache.Keys()).Should(Equal([]string{"a", "c"}))
+            _, found = cache.Get("b")
+            Expect(found).Should(BeFalse())
+            Expect(cache.Stats()).Should(Equal(CacheStats{Hits: 1, Misses: 1, Evictions: 1}))
+        })
+
+        It("Should not change recency on Peek()", func() {
+            cache.Put("a", 1)
+            cache.Put("b", 2)
+            value, _ := cache.Peek("a")
+            Expect(value).Should(Equal(1))
+            cache.Put("c", 3)
+            Expect(evicted).Should(Equal([]string{"a"}))
+            Expect(cache.Stats().Hits).Should(Equal(uint64(0)))
+        })
+
+        It("Should update existing keys without evicting", func() {
+            cache.Put("a", 1)
+            cache.Put("b", 2)
+            cache.Put("a", 10)
+            Expect(cache.Len()).Should(Equal(2))
+            Expect(cache.Keys()).Should(Equal([]string{"b", "a"}))
+            Expect(cache.Remove("b")).Should(BeTrue())
+            Expect(cache.Remove("b")).Should(BeFalse())
+            Expect(evicted).Should(Equal([]string{}))
+            Expect(cache.Cap()).Should(Equal(2))
+        })
+    })
+
+    Context("TTL", func() {
+        It("Should expire entries using the injected clock", func() {
+            clock := NewManualClock(time.Unix(0, 0))
+            cache.SetClock(clock)
+            cache.SetTTL(time.Minute)
+            cache.Put("a", 1)
+            cache.PutWithTTL("b", 2, 0)
+            clock.Advance(59 * time.Second)
+            _, found := cache.Get("a")
+            Expect(found).Should(BeTrue())
+            clock.Advance(time.Second)
+            _, found = cache.Get("a")
+            Expect(found).Should(BeFalse())
+            _, found = cache.Get("b")
+            Expect(found).Should(BeTrue())
+            Expect(evicted).Should(Equal([]string{"a"}))
+            Expect(cache.Stats().Expirations).Should(Equal(uint64(1)))
+        })
+    })
+})
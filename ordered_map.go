@@ -0,0 +1,290 @@
+package gollections
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// Map that remembers the order in which keys were inserted.
+// The zero value is an empty map ready to use.
+type OrderedMap[K comparable, V any] struct {
+    entries map[K]*orderedEntry[K, V]
+    root orderedEntry[K, V]
+}
+
+type orderedEntry[K comparable, V any] struct {
+    key K
+    value V
+    prev, next *orderedEntry[K, V]
+}
+
+// Returns an empty ordered map
+func NewOrderedMap[K comparable, V any] () *OrderedMap[K, V] {
+    return new(OrderedMap[K, V]).lazyInit()
+}
+
+// Returns an ordered map with the given entries in order.
+// Later entries overwrite the values of earlier ones with the same key.
+func OrderedMapOf[K comparable, V any] (entries ...*Pair[K, V]) *OrderedMap[K, V] {
+    orderedMap := NewOrderedMap[K, V]()
+    for _, entry := range entries {
+        orderedMap.Set(entry.First, entry.Second)
+    }
+    return orderedMap
+}
+
+// Sets the value of the key.
+// New keys are appended at the back, existing keys keep their position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+    m.lazyInit()
+    if entry, found := m.entries[key]; found {
+        entry.value = value
+        return
+    }
+    entry := &orderedEntry[K, V]{key: key, value: value}
+    m.entries[key] = entry
+    m.insertAfter(entry, m.root.prev)
+}
+
+// Returns the value of the key and whether the key is there
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+    if entry, found := m.entries[key]; found {
+        return entry.value, true
+    }
+    return zero[V](), false
+}
+
+// Get the value corresponding to the key, returns default value if the key is not there.
+func (m *OrderedMap[K, V]) GetOrDefault(key K, defaultVal V) V {
+    if value, found := m.Get(key); found {
+        return value
+    }
+    return defaultVal
+}
+
+// Returns whether the map contains the key
+func (m *OrderedMap[K, V]) ContainsKey(key K) bool {
+    _, found := m.entries[key]
+    return found
+}
+
+// Deletes the key, returns whether it was there
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+    entry, found := m.entries[key]
+    if !found { return false }
+    delete(m.entries, key)
+    m.unlink(entry)
+    return true
+}
+
+// Returns the number of entries in the map
+func (m *OrderedMap[K, V]) Len() int {
+    return len(m.entries)
+}
+
+// Moves the key to the front of the order, returns whether the key is there
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+    entry, found := m.entries[key]
+    if !found { return false }
+    m.unlink(entry)
+    m.insertAfter(entry, &m.root)
+    return true
+}
+
+// Moves the key to the back of the order, returns whether the key is there
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+    entry, found := m.entries[key]
+    if !found { return false }
+    m.unlink(entry)
+    m.insertAfter(entry, m.root.prev)
+    return true
+}
+
+// Returns a sequence over the entries in insertion order
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+    return func(yield func(K, V) bool) {
+        if m.entries == nil { return }
+        for entry := m.root.next; entry != &m.root; entry = entry.next {
+            if !yield(entry.key, entry.value) { return }
+        }
+    }
+}
+
+// Returns the slice of all keys in insertion order
+func (m *OrderedMap[K, V]) Keys() []K {
+    keys := make([]K, 0, m.Len())
+    for key := range m.All() {
+        keys = append(keys, key)
+    }
+    return keys
+}
+
+// Returns the slice of all values in insertion order
+func (m *OrderedMap[K, V]) Values() []V {
+    values := make([]V, 0, m.Len())
+    for _, value := range m.All() {
+        values = append(values, value)
+    }
+    return values
+}
+
+// Returns a slice key, value pair in insertion order
+func (m *OrderedMap[K, V]) Entries() []*Pair[K, V] {
+    entries := make([]*Pair[K, V], 0, m.Len())
+    for key, value := range m.All() {
+        entries = append(entries, &Pair[K, V]{key, value})
+    }
+    return entries
+}
+
+// Filter keys based on the given predicate, keeping the order
+func (m *OrderedMap[K, V]) FilterKeys(predicate func(K) bool) *OrderedMap[K, V] {
+    filtered := NewOrderedMap[K, V]()
+    if predicate == nil { return filtered }
+    for key, value := range m.All() {
+        if predicate(key) {
+            filtered.Set(key, value)
+        }
+    }
+    return filtered
+}
+
+// Runs operation on each entry of the map in insertion order
+func (m *OrderedMap[K, V]) ForEachEntry(operation func(K, V)) {
+    if operation == nil { return }
+    for key, value := range m.All() {
+        operation(key, value)
+    }
+}
+
+// Encodes the map as a JSON object with keys in insertion order.
+// Keys are encoded the same way encoding/json encodes map keys.
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+    var buf bytes.Buffer
+    buf.WriteByte('{')
+    for key, value := range m.All() {
+        if buf.Len() > 1 {
+            buf.WriteByte(',')
+        }
+        text, err := marshalMapKey(key)
+        if err != nil { return nil, err }
+        encodedKey, err := json.Marshal(text)
+        if err != nil { return nil, err }
+        encodedValue, err := json.Marshal(value)
+        if err != nil { return nil, err }
+        buf.Write(encodedKey)
+        buf.WriteByte(':')
+        buf.Write(encodedValue)
+    }
+    buf.WriteByte('}')
+    return buf.Bytes(), nil
+}
+
+// Replaces the contents of the map with the given JSON object,
+// keeping the order in which keys appear.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+    decoder := json.NewDecoder(bytes.NewReader(data))
+    token, err := decoder.Token()
+    if err != nil { return err }
+    if token == nil { return nil }
+    if token != json.Delim('{') {
+        return fmt.Errorf("cannot unmarshal %v into OrderedMap", token)
+    }
+    decoded := []*Pair[K, V]{}
+    for decoder.More() {
+        token, err := decoder.Token()
+        if err != nil { return err }
+        key, err := unmarshalMapKey[K](token.(string))
+        if err != nil { return err }
+        var value V
+        if err := decoder.Decode(&value); err != nil { return err }
+        decoded = append(decoded, &Pair[K, V]{key, value})
+    }
+    if _, err := decoder.Token(); err != nil { return err }
+    m.entries = nil
+    m.lazyInit()
+    for _, entry := range decoded {
+        m.Set(entry.First, entry.Second)
+    }
+    return nil
+}
+
+// Maps the ordered map into a slice based on the given transform, in insertion order
+func FlatMapOrdered[K comparable, V, R any] (orderedMap *OrderedMap[K, V], transform func(K, V) R) []R {
+    slice := []R{}
+    if orderedMap == nil || transform == nil { return slice }
+    for key, value := range orderedMap.All() {
+        slice = append(slice, transform(key, value))
+    }
+    return slice
+}
+
+// Returns the oldest entry in the order, nil if the map is empty
+func (m *OrderedMap[K, V]) front() *orderedEntry[K, V] {
+    if m.Len() == 0 { return nil }
+    return m.root.next
+}
+
+func (m *OrderedMap[K, V]) lazyInit() *OrderedMap[K, V] {
+    if m.entries == nil {
+        m.entries = make(map[K]*orderedEntry[K, V])
+        m.root.prev, m.root.next = &m.root, &m.root
+    }
+    return m
+}
+
+func (m *OrderedMap[K, V]) insertAfter(entry, at *orderedEntry[K, V]) {
+    entry.prev, entry.next = at, at.next
+    at.next.prev = entry
+    at.next = entry
+}
+
+func (m *OrderedMap[K, V]) unlink(entry *orderedEntry[K, V]) {
+    entry.prev.next = entry.next
+    entry.next.prev = entry.prev
+    entry.prev, entry.next = nil, nil
+}
+
+func marshalMapKey[K comparable] (key K) (string, error) {
+    if marshaler, ok := any(key).(encoding.TextMarshaler); ok {
+        text, err := marshaler.MarshalText()
+        return string(text), err
+    }
+    value := reflect.ValueOf(key)
+    switch value.Kind() {
+    case reflect.String:
+        return value.String(), nil
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return strconv.FormatInt(value.Int(), 10), nil
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+        return strconv.FormatUint(value.Uint(), 10), nil
+    }
+    return "", fmt.Errorf("unsupported map key type %T", key)
+}
+
+func unmarshalMapKey[K comparable] (text string) (K, error) {
+    var key K
+    if unmarshaler, ok := any(&key).(encoding.TextUnmarshaler); ok {
+        return key, unmarshaler.UnmarshalText([]byte(text))
+    }
+    value := reflect.ValueOf(&key).Elem()
+    switch value.Kind() {
+    case reflect.String:
+        value.SetString(text)
+        return key, nil
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        n, err := strconv.ParseInt(text, 10, value.Type().Bits())
+        value.SetInt(n)
+        return key, err
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+        n, err := strconv.ParseUint(text, 10, value.Type().Bits())
+        value.SetUint(n)
+        return key, err
+    }
+    return key, fmt.Errorf("unsupported map key type %T", key)
+}
@@ -0,0 +1,90 @@
+package gollections_test
+
+import (
+	"encoding/json"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for ordered map", func() {
+
+    var orderedMap *OrderedMap[string, int]
+
+    BeforeEach(func() {
+        orderedMap = NewOrderedMap[string, int]()
+        orderedMap.Set("c", 3)
+        orderedMap.Set("a", 1)
+        orderedMap.Set("b", 2)
+    })
+
+    Context("Set(), Get() and Delete()", func() {
+        It("Should keep insertion order", func() {
+            Expect(orderedMap.Keys()).Should(Equal([]string{"c", "a", "b"}))
+            Expect(orderedMap.Values()).Should(Equal([]int{3, 1, 2}))
+            orderedMap.Set("c", 30)
+            value, found := orderedMap.Get("c")
+            Expect(value).Should(Equal(30))
+            Expect(found).Should(BeTrue())
+            Expect(orderedMap.Keys()).Should(Equal([]string{"c", "a", "b"}))
+            Expect(orderedMap.Delete("a")).Should(BeTrue())
+            Expect(orderedMap.Delete("a")).Should(BeFalse())
+            Expect(orderedMap.Entries()).Should(Equal([]*Pair[string, int]{{"c", 30}, {"b", 2}}))
+            Expect(orderedMap.Len()).Should(Equal(2))
+        })
+
+        It("Should work from the zero value", func() {
+            var empty OrderedMap[int, int]
+            Expect(empty.Keys()).Should(Equal([]int{}))
+            _, found := empty.Get(1)
+            Expect(found).Should(BeFalse())
+            empty.Set(1, 2)
+            Expect(empty.Entries()).Should(Equal([]*Pair[int, int]{{1, 2}}))
+        })
+    })
+
+    Context("MoveToFront() and MoveToBack()", func() {
+        It("Should reorder keys", func() {
+            Expect(orderedMap.MoveToFront("b")).Should(BeTrue())
+            Expect(orderedMap.Keys()).Should(Equal([]string{"b", "c", "a"}))
+            Expect(orderedMap.MoveToBack("b")).Should(BeTrue())
+            Expect(orderedMap.Keys()).Should(Equal([]string{"c", "a", "b"}))
+            Expect(orderedMap.MoveToBack("z")).Should(BeFalse())
+        })
+    })
+
+    Context("Map utilities", func() {
+        It("Should behave like their map.go equivalents", func() {
+            Expect(orderedMap.GetOrDefault("a", -1)).Should(Equal(1))
+            Expect(orderedMap.GetOrDefault("z", -1)).Should(Equal(-1))
+            Expect(orderedMap.ContainsKey("b")).Should(BeTrue())
+            Expect(orderedMap.FilterKeys(func(k string) bool { return k != "a" }).Keys()).Should(Equal([]string{"c", "b"}))
+            Expect(orderedMap.FilterKeys(nil).Len()).Should(Equal(0))
+            Expect(FlatMapOrdered(orderedMap, func(k string, v int) string { return k })).Should(Equal([]string{"c", "a", "b"}))
+            keys := ""
+            orderedMap.ForEachEntry(func(k string, v int) { keys += k })
+            Expect(keys).Should(Equal("cab"))
+        })
+    })
+
+    Context("JSON", func() {
+        It("Should preserve key order", func() {
+            encoded, err := json.Marshal(orderedMap)
+            Expect(err).Should(BeNil())
+            Expect(string(encoded)).Should(Equal(`{"c":3,"a":1,"b":2}`))
+
+            decoded := NewOrderedMap[string, int]()
+            decoded.Set("stale", 0)
+            Expect(json.Unmarshal([]byte(`{"z":26,"y":25,"x":24}`), decoded)).Should(BeNil())
+            Expect(decoded.Keys()).Should(Equal([]string{"z", "y", "x"}))
+
+            numbered := OrderedMapOf(&Pair[int, bool]{2, true}, &Pair[int, bool]{1, false})
+            encoded, err = json.Marshal(numbered)
+            Expect(string(encoded)).Should(Equal(`{"2":true,"1":false}`))
+            decodedNumbers := NewOrderedMap[int, bool]()
+            Expect(json.Unmarshal(encoded, decodedNumbers)).Should(BeNil())
+            Expect(decodedNumbers.Entries()).Should(Equal(numbered.Entries()))
+
+            Expect(json.Unmarshal([]byte(`[1]`), decoded)).ShouldNot(BeNil())
+        })
+    })
+})
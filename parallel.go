@@ -0,0 +1,119 @@
+package gollections
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Applies the transform function on each element of the slice
+// using at most concurrency goroutines and returns a slice of the
+// transformed values in the order of the input.
+// A non-positive concurrency uses runtime.GOMAXPROCS(0) goroutines.
+func ParallelMap[T any, R any] (slice []T, transform func(T) R, concurrency int) []R {
+    if transform == nil { return []R{} }
+    transformedSlice := make([]R, len(slice))
+    runParallel(len(slice), concurrency, func(i int) {
+        transformedSlice[i] = transform(slice[i])
+    })
+    return transformedSlice
+}
+
+// Filters the slice based on the given predicate
+// using at most concurrency goroutines.
+// The filtered elements keep the order of the input.
+func ParallelFilter[T any] (slice []T, predicate func(T) bool, concurrency int) []T {
+    filteredSlice := []T{}
+    if predicate == nil { return filteredSlice }
+    keep := make([]bool, len(slice))
+    runParallel(len(slice), concurrency, func(i int) {
+        keep[i] = predicate(slice[i])
+    })
+    for i, elem := range slice {
+        if keep[i] {
+            filteredSlice = append(filteredSlice, elem)
+        }
+    }
+    return filteredSlice
+}
+
+// Performs the given operation for each element in the slice
+// using at most concurrency goroutines.
+// The operation must be safe to call concurrently.
+func ParallelForEach[T any] (slice []T, operation func(T), concurrency int) {
+    if operation == nil { return }
+    runParallel(len(slice), concurrency, func(i int) {
+        operation(slice[i])
+    })
+}
+
+// Accumulates value by splitting the slice into contiguous chunks,
+// folding each chunk from initial using at most concurrency goroutines
+// and merging the partial results from left to right with combiner.
+// The combiner must be associative and initial must be its identity.
+func ParallelFold[T any, R any] (slice []T, initial R, operation func(T, R) R, combiner func(R, R) R, concurrency int) R {
+    if operation == nil || combiner == nil || len(slice) == 0 { return initial }
+    chunks := workerCount(len(slice), concurrency)
+    partials := make([]R, chunks)
+    runParallel(chunks, chunks, func(c int) {
+        from, to := c * len(slice) / chunks, (c + 1) * len(slice) / chunks
+        partials[c] = Fold(slice[from:to], initial, operation)
+    })
+    accumulator := partials[0]
+    for _, partial := range partials[1:] {
+        accumulator = combiner(accumulator, partial)
+    }
+    return accumulator
+}
+
+// Runs task for every index in [0, n) on a bounded pool of goroutines.
+// Once a task panics no new tasks are started and
+// the first panic value is raised again on the calling goroutine.
+func runParallel(n, concurrency int, task func(int)) {
+    runParallelCtx(context.Background(), n, concurrency, task)
+}
+
+// Same as runParallel but stops starting new tasks once ctx is done.
+// If some tasks were never started ctx.Err() is returned
+// after the running ones finish.
+func runParallelCtx(ctx context.Context, n, concurrency int, task func(int)) error {
+    workers := workerCount(n, concurrency)
+    var (
+        next       atomic.Int64
+        panicked   atomic.Bool
+        panicOnce  sync.Once
+        panicValue any
+        wg         sync.WaitGroup
+    )
+    for w := 0; w < workers; w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            defer func() {
+                if r := recover(); r != nil {
+                    panicOnce.Do(func() { panicValue = r })
+                    panicked.Store(true)
+                }
+            }()
+            for !panicked.Load() && ctx.Err() == nil {
+                i := int(next.Add(1) - 1)
+                if i >= n { return }
+                task(i)
+            }
+        }()
+    }
+    wg.Wait()
+    if panicked.Load() {
+        panic(panicValue)
+    }
+    if next.Load() >= int64(n) { return nil }
+    return ctx.Err()
+}
+
+func workerCount(n, concurrency int) int {
+    if concurrency <= 0 {
+        concurrency = runtime.GOMAXPROCS(0)
+    }
+    return min(n, concurrency)
+}
@@ -0,0 +1,69 @@
+package gollections_test
+
+import (
+	"sync/atomic"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for parallel utilities", func() {
+
+    var list []int
+
+    BeforeEach(func() {
+        list = make([]int, 1000)
+        for i := range list {
+            list[i] = i + 1
+        }
+    })
+
+    Context("ParallelMap()", func() {
+        It("Should map in parallel preserving order", func() {
+            square := func(x int) int { return x * x }
+            Expect(ParallelMap(list, square, 8)).Should(Equal(Map(list, square)))
+            Expect(ParallelMap(list, square, 0)).Should(Equal(Map(list, square)))
+            Expect(ParallelMap([]int{}, square, 4)).Should(Equal([]int{}))
+            Expect(ParallelMap[int, int](list, nil, 4)).Should(Equal([]int{}))
+        })
+    })
+
+    Context("ParallelFilter()", func() {
+        It("Should filter in parallel preserving order", func() {
+            even := func(x int) bool { return x % 2 == 0 }
+            Expect(ParallelFilter(list, even, 8)).Should(Equal(Filter(list, even)))
+            Expect(ParallelFilter(list, nil, 8)).Should(Equal([]int{}))
+        })
+    })
+
+    Context("ParallelForEach()", func() {
+        It("Should run the operation on every element", func() {
+            var sum atomic.Int64
+            ParallelForEach(list, func(x int) { sum.Add(int64(x)) }, 3)
+            Expect(sum.Load()).Should(Equal(int64(500500)))
+            ParallelForEach(list, nil, 3)
+        })
+    })
+
+    Context("ParallelFold()", func() {
+        It("Should fold chunks and combine them in order", func() {
+            add := func(a, b int) int { return a + b }
+            Expect(ParallelFold(list, 0, add, add, 7)).Should(Equal(500500))
+            Expect(ParallelFold([]int{}, 0, add, add, 7)).Should(Equal(0))
+
+            concat := func(x int, acc []int) []int { return append(acc, x) }
+            merge := func(a, b []int) []int { return append(a, b...) }
+            Expect(ParallelFold(list, nil, concat, merge, 5)).Should(Equal(list))
+        })
+    })
+
+    Context("Panics", func() {
+        It("Should propagate panics from workers to the caller", func() {
+            Expect(func() {
+                ParallelMap(list, func(x int) int {
+                    if x == 500 { panic("boom") }
+                    return x
+                }, 4)
+            }).Should(PanicWith("boom"))
+        })
+    })
+})
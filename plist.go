@@ -0,0 +1,426 @@
+package gollections
+
+import "iter"
+
+const (
+	plistBits = 5
+	plistWidth = 1 << plistBits
+	// How many nodes above the optimum a level may keep after concatenation
+	plistExtras = 2
+)
+
+// Persistent immutable list.
+// Every update returns a new list sharing most of its structure with the old one,
+// so older versions stay valid and cheap to keep around.
+// Elements are stored in a relaxed radix balanced tree with 32-way branching,
+// making Get, Set, Append, Drop, SubList and Concat O(log32 n).
+// The zero value is an empty list ready to use.
+type PList[T any] struct {
+    root *plistNode[T]
+    // Height of the root, leaves have height 0
+    height int
+}
+
+type plistNode[T any] struct {
+    // Elements of a leaf
+    elems []T
+    // Children of a branch
+    children []*plistNode[T]
+    // Cumulative sizes of the children, nil if the branch is strict:
+    // every child is strict and all but the last one are full,
+    // so the child holding an index is found by radix alone
+    sizes []int
+    size int
+}
+
+// Returns a persistent list of the given elements
+func PListOf[T any] (elems ...T) PList[T] {
+    return plistBuild(elems)
+}
+
+// Returns the number of elements in the list
+func (list PList[T]) Len() int {
+    if list.root == nil { return 0 }
+    return list.root.size
+}
+
+// Returns the element at index, false if the index is invalid
+func (list PList[T]) Get(index int) (T, bool) {
+    if index < 0 || index >= list.Len() { return zero[T](), false }
+    n := list.root
+    for height := list.height; height > 0; height-- {
+        i, offset := n.child(height, index)
+        n, index = n.children[i], index - offset
+    }
+    return n.elems[index], true
+}
+
+// Returns a new list with the element at index replaced.
+// Returns the same list if the index is invalid.
+func (list PList[T]) Set(index int, value T) PList[T] {
+    if index < 0 || index >= list.Len() { return list }
+    return PList[T]{plistSet(list.root, list.height, index, value), list.height}
+}
+
+// Returns a new list with the elements appended at the end
+func (list PList[T]) Append(elems ...T) PList[T] {
+    if len(elems) > plistWidth {
+        return list.Concat(PListOf(elems...))
+    }
+    for _, elem := range elems {
+        list = list.push(elem)
+    }
+    return list
+}
+
+// Returns a new list with the elements of other appended at the end
+func (list PList[T]) Concat(other PList[T]) PList[T] {
+    if list.root == nil { return other }
+    if other.root == nil { return list }
+    height := max(list.height, other.height)
+    nodes := plistConcat(list.root, list.height, other.root, other.height)
+    if len(nodes) == 1 {
+        return plistTrim(nodes[0], height)
+    }
+    return plistTrim(plistBranch(nodes, height + 1), height + 1)
+}
+
+// Drops the value at index and returns the new list.
+// Returns the same list if the index is invalid.
+func (list PList[T]) Drop(index int) PList[T] {
+    if index < 0 || index >= list.Len() { return list }
+    return list.slice(0, index).Concat(list.slice(index + 1, list.Len()))
+}
+
+// Returns the list of elements from `from` upto `to` indecies.
+// Returns an empty list if the indecies are invalid.
+func (list PList[T]) SubList(from, to int) PList[T] {
+    if from < 0 || from >= list.Len() || to < 0 || to >= list.Len() || from > to {
+        return PList[T]{}
+    }
+    return list.slice(from, to + 1)
+}
+
+// Returns a sequence over the indices and elements of the list
+func (list PList[T]) All() iter.Seq2[int, T] {
+    return func(yield func(int, T) bool) {
+        index := 0
+        list.root.walk(func(elem T) bool {
+            index++
+            return yield(index - 1, elem)
+        })
+    }
+}
+
+// Returns a lazy sequence over the elements of the list
+func (list PList[T]) Lazy() Seq[T] {
+    return func(yield func(T) bool) {
+        list.root.walk(yield)
+    }
+}
+
+// Returns the elements of the list as a new slice
+func (list PList[T]) ToSlice() []T {
+    slice := make([]T, 0, list.Len())
+    list.root.walk(func(elem T) bool {
+        slice = append(slice, elem)
+        return true
+    })
+    return slice
+}
+
+// Returns a builder that appends to the list without
+// creating an intermediate version for every element
+func (list PList[T]) Builder() *PListBuilder[T] {
+    return &PListBuilder[T]{base: list}
+}
+
+// Transient builder for persistent lists, useful for batch construction.
+// A builder must not be used from multiple goroutines at once.
+type PListBuilder[T any] struct {
+    base PList[T]
+    pending []T
+}
+
+// Returns a builder for a new empty list
+func NewPListBuilder[T any] () *PListBuilder[T] {
+    return &PListBuilder[T]{}
+}
+
+// Appends the elements to the list being built
+func (builder *PListBuilder[T]) Append(elems ...T) *PListBuilder[T] {
+    builder.pending = append(builder.pending, elems...)
+    return builder
+}
+
+// Returns the number of elements in the list being built
+func (builder *PListBuilder[T]) Len() int {
+    return builder.base.Len() + len(builder.pending)
+}
+
+// Returns the persistent list built so far.
+// The builder can keep being used afterwards without affecting the result.
+func (builder *PListBuilder[T]) Build() PList[T] {
+    if len(builder.pending) > 0 {
+        builder.base = builder.base.Concat(PListOf(builder.pending...))
+        builder.pending = nil
+    }
+    return builder.base
+}
+
+func (list PList[T]) push(value T) PList[T] {
+    if list.root == nil {
+        return PList[T]{plistLeaf([]T{value}), 0}
+    }
+    if root := plistPush(list.root, list.height, value); root != nil {
+        return PList[T]{root, list.height}
+    }
+    root := plistBranch([]*plistNode[T]{list.root, plistPath(list.height, value)}, list.height + 1)
+    return PList[T]{root, list.height + 1}
+}
+
+// Returns the elements from index `from` up to but not including `to`, which must be valid
+func (list PList[T]) slice(from, to int) PList[T] {
+    if from >= to { return PList[T]{} }
+    root := plistSkip(list.root, list.height, from)
+    return plistTrim(plistTake(root, list.height, to - from), list.height)
+}
+
+// Returns the index of the child holding index and how many elements come before that child
+func (n *plistNode[T]) child(height, index int) (int, int) {
+    shift := plistBits * height
+    i := index >> shift
+    if n.sizes == nil { return i, i << shift }
+    // Children hold at most 1 << shift elements, so the radix guess is never past the right child
+    for n.sizes[i] <= index {
+        i++
+    }
+    if i == 0 { return 0, 0 }
+    return i, n.sizes[i - 1]
+}
+
+// Returns the number of elements or children held directly by the node
+func (n *plistNode[T]) slots() int {
+    if n.children == nil { return len(n.elems) }
+    return len(n.children)
+}
+
+func (n *plistNode[T]) walk(yield func(T) bool) bool {
+    if n == nil { return true }
+    for _, elem := range n.elems {
+        if !yield(elem) { return false }
+    }
+    for _, child := range n.children {
+        if !child.walk(yield) { return false }
+    }
+    return true
+}
+
+func plistLeaf[T any] (elems []T) *plistNode[T] {
+    return &plistNode[T]{elems: elems, size: len(elems)}
+}
+
+// Returns a branch of the given height over the children, relaxed only when it has to be
+func plistBranch[T any] (children []*plistNode[T], height int) *plistNode[T] {
+    n := &plistNode[T]{children: children}
+    full := 1 << (plistBits * height)
+    strict := true
+    for i, child := range children {
+        n.size += child.size
+        if child.sizes != nil || (i < len(children) - 1 && child.size != full) {
+            strict = false
+        }
+    }
+    if !strict {
+        n.sizes = make([]int, len(children))
+        total := 0
+        for i, child := range children {
+            total += child.size
+            n.sizes[i] = total
+        }
+    }
+    return n
+}
+
+// Returns a node of the given height holding only the value
+func plistPath[T any] (height int, value T) *plistNode[T] {
+    n := plistLeaf([]T{value})
+    for h := 1; h <= height; h++ {
+        n = plistBranch([]*plistNode[T]{n}, h)
+    }
+    return n
+}
+
+// Drops the single child roots left behind by splitting or concatenating
+func plistTrim[T any] (root *plistNode[T], height int) PList[T] {
+    for height > 0 && len(root.children) == 1 {
+        root, height = root.children[0], height - 1
+    }
+    return PList[T]{root, height}
+}
+
+func plistBuild[T any] (elems []T) PList[T] {
+    if len(elems) == 0 { return PList[T]{} }
+    nodes := make([]*plistNode[T], 0, (len(elems) + plistWidth - 1) / plistWidth)
+    for from := 0; from < len(elems); from += plistWidth {
+        to := min(from + plistWidth, len(elems))
+        nodes = append(nodes, plistLeaf(append([]T(nil), elems[from:to]...)))
+    }
+    height := 0
+    for len(nodes) > 1 {
+        height++
+        parents := make([]*plistNode[T], 0, (len(nodes) + plistWidth - 1) / plistWidth)
+        for from := 0; from < len(nodes); from += plistWidth {
+            to := min(from + plistWidth, len(nodes))
+            parents = append(parents, plistBranch(nodes[from:to:to], height))
+        }
+        nodes = parents
+    }
+    return PList[T]{nodes[0], height}
+}
+
+func plistSet[T any] (n *plistNode[T], height, index int, value T) *plistNode[T] {
+    if height == 0 {
+        elems := append([]T(nil), n.elems...)
+        elems[index] = value
+        return plistLeaf(elems)
+    }
+    i, offset := n.child(height, index)
+    children := append([]*plistNode[T](nil), n.children...)
+    children[i] = plistSet(children[i], height - 1, index - offset, value)
+    return &plistNode[T]{children: children, sizes: n.sizes, size: n.size}
+}
+
+// Returns the node with the value appended, nil if the node has no room left
+func plistPush[T any] (n *plistNode[T], height int, value T) *plistNode[T] {
+    if height == 0 {
+        if len(n.elems) == plistWidth { return nil }
+        elems := make([]T, len(n.elems), len(n.elems) + 1)
+        copy(elems, n.elems)
+        return plistLeaf(append(elems, value))
+    }
+    last := len(n.children) - 1
+    if child := plistPush(n.children[last], height - 1, value); child != nil {
+        children := append([]*plistNode[T](nil), n.children...)
+        children[last] = child
+        return plistBranch(children, height)
+    }
+    if len(n.children) == plistWidth { return nil }
+    children := make([]*plistNode[T], len(n.children), len(n.children) + 1)
+    copy(children, n.children)
+    return plistBranch(append(children, plistPath(height - 1, value)), height)
+}
+
+// Returns the first count elements of the node, where 0 < count <= n.size
+func plistTake[T any] (n *plistNode[T], height, count int) *plistNode[T] {
+    if count == n.size { return n }
+    if height == 0 { return plistLeaf(n.elems[:count:count]) }
+    i, offset := n.child(height, count - 1)
+    children := make([]*plistNode[T], i, i + 1)
+    copy(children, n.children[:i])
+    children = append(children, plistTake(n.children[i], height - 1, count - offset))
+    return plistBranch(children, height)
+}
+
+// Returns the node without its first count elements, where 0 <= count < n.size
+func plistSkip[T any] (n *plistNode[T], height, count int) *plistNode[T] {
+    if count == 0 { return n }
+    if height == 0 { return plistLeaf(n.elems[count:]) }
+    i, offset := n.child(height, count)
+    children := append([]*plistNode[T]{plistSkip(n.children[i], height - 1, count - offset)}, n.children[i + 1:]...)
+    return plistBranch(children, height)
+}
+
+// Concatenates the trees and returns one or two nodes of the greater height
+func plistConcat[T any] (left *plistNode[T], leftHeight int, right *plistNode[T], rightHeight int) []*plistNode[T] {
+    switch {
+    case leftHeight > rightHeight:
+        last := len(left.children) - 1
+        middle := plistConcat(left.children[last], leftHeight - 1, right, rightHeight)
+        return plistRebalance(left.children[:last], middle, nil, leftHeight)
+    case leftHeight < rightHeight:
+        middle := plistConcat(left, leftHeight, right.children[0], rightHeight - 1)
+        return plistRebalance(nil, middle, right.children[1:], rightHeight)
+    case leftHeight == 0:
+        if left.size + right.size <= plistWidth {
+            return []*plistNode[T]{plistLeaf(append(append([]T(nil), left.elems...), right.elems...))}
+        }
+        return []*plistNode[T]{left, right}
+    }
+    last := len(left.children) - 1
+    middle := plistConcat(left.children[last], leftHeight - 1, right.children[0], rightHeight - 1)
+    return plistRebalance(left.children[:last], middle, right.children[1:], leftHeight)
+}
+
+// Joins the children around the seam of a concatenation into one or two nodes of the given height,
+// redistributing them first when there are too many sparse ones
+func plistRebalance[T any] (left, middle, right []*plistNode[T], height int) []*plistNode[T] {
+    all := make([]*plistNode[T], 0, len(left) + len(middle) + len(right))
+    all = append(append(append(all, left...), middle...), right...)
+    all = plistRedistribute(all, height - 1)
+    if len(all) <= plistWidth {
+        return []*plistNode[T]{plistBranch(all, height)}
+    }
+    return []*plistNode[T]{plistBranch(all[:plistWidth:plistWidth], height), plistBranch(all[plistWidth:], height)}
+}
+
+// Moves slots between adjacent nodes of the given height until there are
+// at most plistExtras more nodes than needed to hold all slots.
+// Nodes that keep their slots are reused as they are.
+func plistRedistribute[T any] (nodes []*plistNode[T], height int) []*plistNode[T] {
+    counts := make([]int, len(nodes))
+    total := 0
+    for i, n := range nodes {
+        counts[i] = n.slots()
+        total += counts[i]
+    }
+    optimal := (total + plistWidth - 1) / plistWidth
+    count := len(counts)
+    if count <= optimal + plistExtras { return nodes }
+    for count > optimal + plistExtras {
+        i := 0
+        for counts[i] > plistWidth - plistExtras / 2 {
+            i++
+        }
+        // Merge node i into the following ones, shifting slots left until a node empties
+        for remaining := counts[i]; remaining > 0; i++ {
+            filled := min(remaining + counts[i + 1], plistWidth)
+            remaining += counts[i + 1] - filled
+            counts[i] = filled
+        }
+        copy(counts[i:count - 1], counts[i + 1:count])
+        count--
+    }
+
+    result := make([]*plistNode[T], 0, count)
+    j, offset := 0, 0
+    for _, slots := range counts[:count] {
+        if offset == 0 && nodes[j].slots() == slots {
+            result = append(result, nodes[j])
+            j++
+            continue
+        }
+        if height == 0 {
+            elems := make([]T, 0, slots)
+            for len(elems) < slots {
+                taken := min(slots - len(elems), len(nodes[j].elems) - offset)
+                elems = append(elems, nodes[j].elems[offset:offset + taken]...)
+                if offset += taken; offset == len(nodes[j].elems) {
+                    j, offset = j + 1, 0
+                }
+            }
+            result = append(result, plistLeaf(elems))
+            continue
+        }
+        children := make([]*plistNode[T], 0, slots)
+        for len(children) < slots {
+            taken := min(slots - len(children), len(nodes[j].children) - offset)
+            children = append(children, nodes[j].children[offset:offset + taken]...)
+            if offset += taken; offset == len(nodes[j].children) {
+                j, offset = j + 1, 0
+            }
+        }
+        result = append(result, plistBranch(children, height))
+    }
+    return result
+}
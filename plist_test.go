@@ -0,0 +1,141 @@
+package gollections_test
+
+import (
+	"math/rand"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for persistent list", func() {
+
+    var list PList[int]
+
+    BeforeEach(func() {
+        list = PListOf(1, 2, 3, 4, 5)
+    })
+
+    Context("Get() and Set()", func() {
+        It("Should read and update without touching older versions", func() {
+            value, found := list.Get(2)
+            Expect(value).Should(Equal(3))
+            Expect(found).Should(BeTrue())
+            _, found = list.Get(5)
+            Expect(found).Should(BeFalse())
+
+            updated := list.Set(2, 30)
+            Expect(updated.ToSlice()).Should(Equal([]int{1, 2, 30, 4, 5}))
+            Expect(list.ToSlice()).Should(Equal([]int{1, 2, 3, 4, 5}))
+            Expect(list.Set(-1, 0)).Should(Equal(list))
+        })
+    })
+
+    Context("Append() and Concat()", func() {
+        It("Should grow the list persistently", func() {
+            var empty PList[int]
+            Expect(empty.Len()).Should(Equal(0))
+            Expect(empty.ToSlice()).Should(Equal([]int{}))
+
+            appended := list.Append(6, 7)
+            Expect(appended.ToSlice()).Should(Equal([]int{1, 2, 3, 4, 5, 6, 7}))
+            Expect(list.Len()).Should(Equal(5))
+            Expect(list.Concat(appended).Len()).Should(Equal(12))
+
+            large := PList[int]{}
+            expected := []int{}
+            for i := 0; i < 1000; i++ {
+                large = large.Append(i)
+                expected = append(expected, i)
+            }
+            Expect(large.ToSlice()).Should(Equal(expected))
+            Expect(large.Concat(large).SubList(995, 1004).ToSlice()).Should(Equal([]int{995, 996, 997, 998, 999, 0, 1, 2, 3, 4}))
+        })
+    })
+
+    Context("Drop() and SubList()", func() {
+        It("Should behave like their slice counterparts", func() {
+            Expect(list.Drop(1).ToSlice()).Should(Equal(Drop([]int{1, 2, 3, 4, 5}, 1)))
+            Expect(list.Drop(7)).Should(Equal(list))
+            Expect(list.SubList(1, 3).ToSlice()).Should(Equal([]int{2, 3, 4}))
+            Expect(list.SubList(-1, 3).Len()).Should(Equal(0))
+            Expect(list.ToSlice()).Should(Equal([]int{1, 2, 3, 4, 5}))
+        })
+    })
+
+    Context("Random operations", func() {
+        It("Should match a slice through appends, concats, drops and sublists", func() {
+            random := rand.New(rand.NewSource(11))
+            persistent, reference := PList[int]{}, []int{}
+            snapshot, snapshotSlice := persistent, []int{}
+            for i := 0; i < 1500; i++ {
+                switch random.Intn(5) {
+                case 0:
+                    persistent, reference = persistent.Append(i), append(reference, i)
+                case 1:
+                    extra := make([]int, random.Intn(100))
+                    for j := range extra {
+                        extra[j] = i * 1000 + j
+                    }
+                    persistent, reference = persistent.Concat(PListOf(extra...)), append(reference, extra...)
+                case 2:
+                    if len(reference) > 0 {
+                        index := random.Intn(len(reference))
+                        persistent, reference = persistent.Drop(index), Drop(reference, index)
+                    }
+                case 3:
+                    if len(reference) > 1 {
+                        from := random.Intn(len(reference) / 2)
+                        persistent, reference = persistent.SubList(from, len(reference) - 1), SubList(reference, from, len(reference) - 1)
+                    }
+                case 4:
+                    if len(reference) > 0 {
+                        index := random.Intn(len(reference))
+                        persistent = persistent.Set(index, -i)
+                        reference = append([]int{}, reference...)
+                        reference[index] = -i
+                    }
+                }
+                if i == 700 {
+                    snapshot, snapshotSlice = persistent, append([]int{}, reference...)
+                }
+            }
+            Expect(persistent.ToSlice()).Should(Equal(reference))
+            for index, elem := range reference {
+                value, _ := persistent.Get(index)
+                Expect(value).Should(Equal(elem))
+            }
+            Expect(snapshot.ToSlice()).Should(Equal(snapshotSlice))
+        })
+    })
+
+    Context("Iteration", func() {
+        It("Should iterate in order and work with sequences", func() {
+            indices := []int{}
+            for i, elem := range list.All() {
+                Expect(elem).Should(Equal(i + 1))
+                indices = append(indices, i)
+            }
+            Expect(indices).Should(Equal([]int{0, 1, 2, 3, 4}))
+            Expect(list.Lazy().Filter(func(x int) bool { return x > 2 }).Take(2).Collect()).Should(Equal([]int{3, 4}))
+        })
+    })
+
+    Context("Builder()", func() {
+        It("Should build lists in batches", func() {
+            builder := list.Builder()
+            for i := 6; i <= 100; i++ {
+                builder.Append(i)
+            }
+            Expect(builder.Len()).Should(Equal(100))
+            built := builder.Build()
+            Expect(built.Len()).Should(Equal(100))
+            last, _ := built.Get(99)
+            Expect(last).Should(Equal(100))
+            Expect(list.Len()).Should(Equal(5))
+
+            builder.Append(101)
+            Expect(built.Len()).Should(Equal(100))
+            Expect(builder.Build().Len()).Should(Equal(101))
+            Expect(NewPListBuilder[string]().Append("a", "b").Build().ToSlice()).Should(Equal([]string{"a", "b"}))
+        })
+    })
+})
@@ -0,0 +1,304 @@
+package gollections
+
+import (
+	"hash/maphash"
+	"iter"
+	"math/bits"
+)
+
+const (
+	pmapBits = 5
+	pmapMask = 1 << pmapBits - 1
+)
+
+var pmapSeed = maphash.MakeSeed()
+
+// Persistent immutable hash map, implemented as a hash array mapped trie.
+// Put and Delete return a new map sharing most of its structure with the old one,
+// so snapshots can be read concurrently without locks.
+// The zero value is an empty map ready to use.
+type PMap[K comparable, V any] struct {
+    root *pmapNode[K, V]
+    size int
+    hasher func(K) uint64
+}
+
+type pmapNode[K comparable, V any] struct {
+    bitmap uint32
+    entries []pmapEntry[K, V]
+    // Set when all entries are leaves whose hashes fully collide
+    collision bool
+}
+
+// Either a leaf holding a key and value or a pointer to a child node
+type pmapEntry[K comparable, V any] struct {
+    child *pmapNode[K, V]
+    hash uint64
+    key K
+    value V
+}
+
+// Returns an empty persistent map
+func NewPMap[K comparable, V any] () PMap[K, V] {
+    return PMap[K, V]{}
+}
+
+// Returns an empty persistent map that hashes keys with the given hasher.
+// Keys that are equal must have equal hashes.
+func NewPMapWithHasher[K comparable, V any] (hasher func(K) uint64) PMap[K, V] {
+    return PMap[K, V]{hasher: hasher}
+}
+
+// Returns a persistent map with the entries of the given map
+func PMapFrom[K comparable, V any] (hashMap map[K]V) PMap[K, V] {
+    pmap := NewPMap[K, V]()
+    for key, value := range hashMap {
+        pmap = pmap.Put(key, value)
+    }
+    return pmap
+}
+
+// Returns the number of entries in the map
+func (m PMap[K, V]) Len() int {
+    return m.size
+}
+
+// Returns the value of the key and whether the key is there
+func (m PMap[K, V]) Get(key K) (V, bool) {
+    hash := m.hash(key)
+    n := m.root
+    for shift := 0; n != nil; shift += pmapBits {
+        if n.collision {
+            if i := n.find(key); i >= 0 {
+                return n.entries[i].value, true
+            }
+            break
+        }
+        bit, index := n.position(hash, shift)
+        if n.bitmap & bit == 0 { break }
+        entry := &n.entries[index]
+        if entry.child == nil {
+            if entry.key == key {
+                return entry.value, true
+            }
+            break
+        }
+        n = entry.child
+    }
+    return zero[V](), false
+}
+
+// Get the value corresponding to the key, returns default value if the key is not there.
+func (m PMap[K, V]) GetOrDefault(key K, defaultVal V) V {
+    if value, found := m.Get(key); found {
+        return value
+    }
+    return defaultVal
+}
+
+// Returns whether the map contains the key
+func (m PMap[K, V]) ContainsKey(key K) bool {
+    _, found := m.Get(key)
+    return found
+}
+
+// Returns a new map with the key set to value
+func (m PMap[K, V]) Put(key K, value V) PMap[K, V] {
+    leaf := pmapEntry[K, V]{hash: m.hash(key), key: key, value: value}
+    root, added := m.root.put(0, leaf)
+    if added {
+        m.size++
+    }
+    m.root = root
+    return m
+}
+
+// Returns a new map without the key.
+// Returns the same map if the key is not there.
+func (m PMap[K, V]) Delete(key K) PMap[K, V] {
+    root, deleted := m.root.delete(0, m.hash(key), key)
+    if !deleted { return m }
+    m.root = root
+    m.size--
+    return m
+}
+
+// Returns a sequence over the entries in no particular order
+func (m PMap[K, V]) All() iter.Seq2[K, V] {
+    return func(yield func(K, V) bool) {
+        m.root.walk(yield)
+    }
+}
+
+// Returns the slice of all keys
+func (m PMap[K, V]) Keys() []K {
+    keys := make([]K, 0, m.size)
+    for key := range m.All() {
+        keys = append(keys, key)
+    }
+    return keys
+}
+
+// Returns the slice of all values
+func (m PMap[K, V]) Values() []V {
+    values := make([]V, 0, m.size)
+    for _, value := range m.All() {
+        values = append(values, value)
+    }
+    return values
+}
+
+// Returns a slice key, value pair
+func (m PMap[K, V]) Entries() []*Pair[K, V] {
+    entries := make([]*Pair[K, V], 0, m.size)
+    for key, value := range m.All() {
+        entries = append(entries, &Pair[K, V]{key, value})
+    }
+    return entries
+}
+
+// Filter keys based on the given predicate
+func (m PMap[K, V]) FilterKeys(predicate func(K) bool) PMap[K, V] {
+    filtered := PMap[K, V]{hasher: m.hasher}
+    if predicate == nil { return filtered }
+    for key, value := range m.All() {
+        if predicate(key) {
+            filtered = filtered.Put(key, value)
+        }
+    }
+    return filtered
+}
+
+// Runs operation on each entry of the map
+func (m PMap[K, V]) ForEachEntry(operation func(K, V)) {
+    if operation == nil { return }
+    for key, value := range m.All() {
+        operation(key, value)
+    }
+}
+
+// Returns the entries as a native map
+func (m PMap[K, V]) ToMap() map[K]V {
+    hashMap := make(map[K]V, m.size)
+    for key, value := range m.All() {
+        hashMap[key] = value
+    }
+    return hashMap
+}
+
+func (m PMap[K, V]) hash(key K) uint64 {
+    if m.hasher != nil {
+        return m.hasher(key)
+    }
+    return maphash.Comparable(pmapSeed, key)
+}
+
+func (n *pmapNode[K, V]) position(hash uint64, shift int) (uint32, int) {
+    bit := uint32(1) << ((hash >> shift) & pmapMask)
+    return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *pmapNode[K, V]) find(key K) int {
+    for i, entry := range n.entries {
+        if entry.key == key {
+            return i
+        }
+    }
+    return -1
+}
+
+// Returns a copy of the node with the entry at index replaced
+func (n *pmapNode[K, V]) with(index int, entry pmapEntry[K, V]) *pmapNode[K, V] {
+    entries := append([]pmapEntry[K, V](nil), n.entries...)
+    entries[index] = entry
+    return &pmapNode[K, V]{bitmap: n.bitmap, entries: entries, collision: n.collision}
+}
+
+func (n *pmapNode[K, V]) put(shift int, leaf pmapEntry[K, V]) (*pmapNode[K, V], bool) {
+    if n == nil {
+        bit := uint32(1) << ((leaf.hash >> shift) & pmapMask)
+        return &pmapNode[K, V]{bitmap: bit, entries: []pmapEntry[K, V]{leaf}}, true
+    }
+    if n.collision {
+        if i := n.find(leaf.key); i >= 0 {
+            return n.with(i, leaf), false
+        }
+        entries := append(append([]pmapEntry[K, V](nil), n.entries...), leaf)
+        return &pmapNode[K, V]{entries: entries, collision: true}, true
+    }
+    bit, index := n.position(leaf.hash, shift)
+    if n.bitmap & bit == 0 {
+        entries := make([]pmapEntry[K, V], 0, len(n.entries) + 1)
+        entries = append(append(append(entries, n.entries[:index]...), leaf), n.entries[index:]...)
+        return &pmapNode[K, V]{bitmap: n.bitmap | bit, entries: entries}, true
+    }
+    existing := n.entries[index]
+    switch {
+    case existing.child != nil:
+        child, added := existing.child.put(shift + pmapBits, leaf)
+        return n.with(index, pmapEntry[K, V]{child: child}), added
+    case existing.key == leaf.key:
+        return n.with(index, leaf), false
+    }
+    child := pmapMerge(shift + pmapBits, existing, leaf)
+    return n.with(index, pmapEntry[K, V]{child: child}), true
+}
+
+// Returns a node holding two leaves with distinct keys
+func pmapMerge[K comparable, V any] (shift int, a, b pmapEntry[K, V]) *pmapNode[K, V] {
+    if shift >= 64 {
+        return &pmapNode[K, V]{entries: []pmapEntry[K, V]{a, b}, collision: true}
+    }
+    bitA := uint32(1) << ((a.hash >> shift) & pmapMask)
+    bitB := uint32(1) << ((b.hash >> shift) & pmapMask)
+    switch {
+    case bitA == bitB:
+        child := pmapMerge(shift + pmapBits, a, b)
+        return &pmapNode[K, V]{bitmap: bitA, entries: []pmapEntry[K, V]{{child: child}}}
+    case bitA < bitB:
+        return &pmapNode[K, V]{bitmap: bitA | bitB, entries: []pmapEntry[K, V]{a, b}}
+    }
+    return &pmapNode[K, V]{bitmap: bitA | bitB, entries: []pmapEntry[K, V]{b, a}}
+}
+
+// Returns the node without the key, nil if it ends up empty
+func (n *pmapNode[K, V]) delete(shift int, hash uint64, key K) (*pmapNode[K, V], bool) {
+    if n == nil { return nil, false }
+    var index int
+    var bit uint32
+    if n.collision {
+        if index = n.find(key); index < 0 { return n, false }
+    } else {
+        if bit, index = n.position(hash, shift); n.bitmap & bit == 0 { return n, false }
+        if existing := n.entries[index]; existing.child != nil {
+            child, deleted := existing.child.delete(shift + pmapBits, hash, key)
+            switch {
+            case !deleted:
+                return n, false
+            case child != nil && (len(child.entries) > 1 || child.entries[0].child != nil):
+                return n.with(index, pmapEntry[K, V]{child: child}), true
+            case child != nil:
+                // Pull a lone leaf up into this node
+                return n.with(index, child.entries[0]), true
+            }
+        } else if existing.key != key {
+            return n, false
+        }
+    }
+    if len(n.entries) == 1 { return nil, true }
+    entries := make([]pmapEntry[K, V], 0, len(n.entries) - 1)
+    entries = append(append(entries, n.entries[:index]...), n.entries[index + 1:]...)
+    return &pmapNode[K, V]{bitmap: n.bitmap &^ bit, entries: entries, collision: n.collision}, true
+}
+
+func (n *pmapNode[K, V]) walk(yield func(K, V) bool) bool {
+    if n == nil { return true }
+    for _, entry := range n.entries {
+        if entry.child != nil {
+            if !entry.child.walk(yield) { return false }
+        } else if !yield(entry.key, entry.value) {
+            return false
+        }
+    }
+    return true
+}
@@ -0,0 +1,84 @@
+package gollections_test
+
+import (
+	"math/rand"
+	"sort"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for persistent map", func() {
+
+    var pmap PMap[string, int]
+
+    BeforeEach(func() {
+        pmap = NewPMap[string, int]().Put("a", 1).Put("b", 2).Put("c", 3)
+    })
+
+    Context("Put(), Get() and Delete()", func() {
+        It("Should keep older versions intact", func() {
+            updated := pmap.Put("a", 10).Put("d", 4)
+            deleted := updated.Delete("b")
+
+            Expect(pmap.ToMap()).Should(Equal(map[string]int{"a": 1, "b": 2, "c": 3}))
+            Expect(updated.ToMap()).Should(Equal(map[string]int{"a": 10, "b": 2, "c": 3, "d": 4}))
+            Expect(deleted.ToMap()).Should(Equal(map[string]int{"a": 10, "c": 3, "d": 4}))
+            Expect(deleted.Len()).Should(Equal(3))
+            Expect(deleted.Delete("z")).Should(Equal(deleted))
+
+            value, found := updated.Get("a")
+            Expect(value).Should(Equal(10))
+            Expect(found).Should(BeTrue())
+            Expect(pmap.ContainsKey("d")).Should(BeFalse())
+
+            var empty PMap[int, int]
+            Expect(empty.Len()).Should(Equal(0))
+            Expect(empty.Delete(1).Len()).Should(Equal(0))
+        })
+
+        It("Should match a native map under random operations and collisions", func() {
+            for _, hasher := range []func(int) uint64{nil, func(k int) uint64 { return uint64(k % 7) }} {
+                random := rand.New(rand.NewSource(7))
+                pmap := NewPMapWithHasher[int, int](hasher)
+                reference := map[int]int{}
+                for i := 0; i < 3000; i++ {
+                    key := random.Intn(500)
+                    if random.Intn(3) == 0 {
+                        pmap = pmap.Delete(key)
+                        delete(reference, key)
+                    } else {
+                        pmap = pmap.Put(key, i)
+                        reference[key] = i
+                    }
+                }
+                Expect(pmap.Len()).Should(Equal(len(reference)))
+                Expect(pmap.ToMap()).Should(Equal(reference))
+                for key := range reference {
+                    pmap = pmap.Delete(key)
+                }
+                Expect(pmap.Len()).Should(Equal(0))
+                Expect(pmap.Keys()).Should(Equal([]int{}))
+            }
+        })
+    })
+
+    Context("Map utilities", func() {
+        It("Should behave like their map.go equivalents", func() {
+            Expect(pmap.GetOrDefault("a", -1)).Should(Equal(1))
+            Expect(pmap.GetOrDefault("z", -1)).Should(Equal(-1))
+            Expect(pmap.FilterKeys(func(k string) bool { return k != "b" }).ToMap()).Should(Equal(map[string]int{"a": 1, "c": 3}))
+            Expect(pmap.FilterKeys(nil).Len()).Should(Equal(0))
+
+            keys := pmap.Keys()
+            sort.Strings(keys)
+            Expect(keys).Should(Equal([]string{"a", "b", "c"}))
+            Expect(Fold(pmap.Values(), 0, func(a, b int) int { return a + b })).Should(Equal(6))
+            Expect(Associate(pmap.Entries(), func(p *Pair[string, int]) (string, int) { return p.First, p.Second })).Should(Equal(pmap.ToMap()))
+            Expect(PMapFrom(map[string]int{"x": 1}).ToMap()).Should(Equal(map[string]int{"x": 1}))
+
+            sum := 0
+            pmap.ForEachEntry(func(k string, v int) { sum += v })
+            Expect(sum).Should(Equal(6))
+        })
+    })
+})
@@ -0,0 +1,66 @@
+package gollections
+
+// Returns a predicate satisfied by elements that do not satisfy the given one
+func Not[T any] (predicate func(T) bool) func(T) bool {
+    return func(elem T) bool { return !predicate(elem) }
+}
+
+// Returns a predicate satisfied by elements satisfying both predicates.
+// The second predicate is not run if the first one fails.
+func And[T any] (a, b func(T) bool) func(T) bool {
+    return func(elem T) bool { return a(elem) && b(elem) }
+}
+
+// Returns a predicate satisfied by elements satisfying either predicate.
+// The second predicate is not run if the first one passes.
+func Or[T any] (a, b func(T) bool) func(T) bool {
+    return func(elem T) bool { return a(elem) || b(elem) }
+}
+
+// Returns a predicate satisfied by elements satisfying exactly one of the predicates
+func Xor[T any] (a, b func(T) bool) func(T) bool {
+    return func(elem T) bool { return a(elem) != b(elem) }
+}
+
+// Returns a predicate satisfied by elements satisfying every predicate.
+// Satisfied by everything if there are no predicates.
+func AllOf[T any] (predicates ...func(T) bool) func(T) bool {
+    return func(elem T) bool {
+        for _, predicate := range predicates {
+            if !predicate(elem) { return false }
+        }
+        return true
+    }
+}
+
+// Returns a predicate satisfied by elements satisfying at least one predicate.
+// Satisfied by nothing if there are no predicates.
+func AnyOf[T any] (predicates ...func(T) bool) func(T) bool {
+    return func(elem T) bool {
+        for _, predicate := range predicates {
+            if predicate(elem) { return true }
+        }
+        return false
+    }
+}
+
+// Returns a predicate satisfied by elements satisfying none of the predicates
+func NoneOf[T any] (predicates ...func(T) bool) func(T) bool {
+    return Not(AnyOf(predicates...))
+}
+
+// Returns a predicate satisfied by elements equal to the value
+func Equals[T comparable] (value T) func(T) bool {
+    return func(elem T) bool { return elem == value }
+}
+
+// Returns a predicate satisfied by elements equal to any of the values
+func In[T comparable] (values ...T) func(T) bool {
+    set := NewSet(values...)
+    return set.Has
+}
+
+// Returns a predicate satisfied by elements whose key satisfies the given predicate
+func By[T any, K any] (key func(T) K, predicate func(K) bool) func(T) bool {
+    return func(elem T) bool { return predicate(key(elem)) }
+}
@@ -0,0 +1,55 @@
+package gollections_test
+
+import (
+	"strings"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for predicates", func() {
+
+    list := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+    even := func(x int) bool { return x % 2 == 0 }
+    large := func(x int) bool { return x > 6 }
+
+    Context("Not(), And(), Or() and Xor()", func() {
+        It("Should combine two predicates", func() {
+            Expect(Filter(list, Not(even))).Should(Equal([]int{1, 3, 5, 7, 9}))
+            Expect(Filter(list, And(even, large))).Should(Equal([]int{8, 10}))
+            Expect(Filter(list, Or(even, large))).Should(Equal([]int{2, 4, 6, 7, 8, 9, 10}))
+            Expect(Filter(list, Xor(even, large))).Should(Equal([]int{2, 4, 6, 7, 9}))
+        })
+
+        It("Should short circuit", func() {
+            calls := 0
+            counted := func(int) bool { calls++; return true }
+            And(Not(even), counted)(2)
+            Or(even, counted)(2)
+            Expect(calls).Should(Equal(0))
+        })
+    })
+
+    Context("AllOf(), AnyOf() and NoneOf()", func() {
+        It("Should combine any number of predicates", func() {
+            small := func(x int) bool { return x < 3 }
+            Expect(Filter(list, AllOf(even, large, Not(Equals(8))))).Should(Equal([]int{10}))
+            Expect(Filter(list, AnyOf(small, large))).Should(Equal([]int{1, 2, 7, 8, 9, 10}))
+            Expect(Filter(list, NoneOf(small, large, even))).Should(Equal([]int{3, 5}))
+            Expect(All(list, AllOf[int]())).Should(BeTrue())
+            Expect(Any(list, AnyOf[int]())).Should(BeFalse())
+        })
+    })
+
+    Context("Equals(), In() and By()", func() {
+        It("Should build predicates from values and keys", func() {
+            words := []string{"go", "rust", "zig", "java", "c"}
+            Expect(First(words, Equals("zig"))).Should(Equal("zig"))
+            Expect(Filter(words, In("c", "go", "python"))).Should(Equal([]string{"go", "c"}))
+            Expect(Filter(words, By(func(s string) int { return len(s) }, large))).Should(Equal([]string{}))
+            Expect(Filter(words, By(strings.ToUpper, In("RUST", "JAVA")))).Should(Equal([]string{"rust", "java"}))
+            left, right := Partition(words, By(func(s string) int { return len(s) }, even))
+            Expect(left).Should(Equal([]string{"go", "rust", "java"}))
+            Expect(right).Should(Equal([]string{"zig", "c"}))
+        })
+    })
+})
@@ -0,0 +1,180 @@
+package gollections
+
+import (
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Priority queue backed by a binary heap.
+// Pop returns the smallest element according to the comparer.
+type PriorityQueue[T any] struct {
+    items []*PQHandle[T]
+    comparer func(T, T) int
+}
+
+// Handle to an element pushed into a priority queue,
+// used to update or remove that element later
+type PQHandle[T any] struct {
+    value T
+    index int
+    queue *PriorityQueue[T]
+}
+
+// Returns the element the handle refers to
+func (h *PQHandle[T]) Value() T {
+    return h.value
+}
+
+// Returns an empty priority queue ordered by the comparer function.
+// If a > b then comparer(a, b) > 0, and the smallest element is popped first.
+func NewPriorityQueue[T any] (comparer func(T, T) int) *PriorityQueue[T] {
+    return &PriorityQueue[T]{comparer: comparer}
+}
+
+// Returns an empty priority queue that pops the smallest element first
+func NewMinPriorityQueue[T constraints.Ordered] () *PriorityQueue[T] {
+    return NewPriorityQueue(ComparingOrdered[T])
+}
+
+// Returns an empty priority queue that pops the largest element first
+func NewMaxPriorityQueue[T constraints.Ordered] () *PriorityQueue[T] {
+    return NewPriorityQueue(Reverse(ComparingOrdered[T]))
+}
+
+// Returns a priority queue of the elements of the slice, built in O(n).
+// The slice itself is not modified.
+func Heapify[T any] (slice []T, comparer func(T, T) int) *PriorityQueue[T] {
+    pq := NewPriorityQueue(comparer)
+    pq.items = make([]*PQHandle[T], len(slice))
+    for i, elem := range slice {
+        pq.items[i] = &PQHandle[T]{elem, i, pq}
+    }
+    for i := len(pq.items) / 2 - 1; i >= 0; i-- {
+        pq.down(i)
+    }
+    return pq
+}
+
+// Returns the number of elements in the queue
+func (pq *PriorityQueue[T]) Len() int {
+    return len(pq.items)
+}
+
+// Adds the element and returns a handle to it
+func (pq *PriorityQueue[T]) Push(elem T) *PQHandle[T] {
+    handle := &PQHandle[T]{elem, len(pq.items), pq}
+    pq.items = append(pq.items, handle)
+    pq.up(handle.index)
+    return handle
+}
+
+// Returns the smallest element without removing it, false if empty
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+    if len(pq.items) == 0 { return zero[T](), false }
+    return pq.items[0].value, true
+}
+
+// Removes and returns the smallest element, false if empty
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+    if len(pq.items) == 0 { return zero[T](), false }
+    return pq.removeAt(0), true
+}
+
+// Replaces the element of the handle and restores the heap order.
+// Returns false if the handle is not in this queue anymore.
+func (pq *PriorityQueue[T]) Update(handle *PQHandle[T], elem T) bool {
+    if !pq.owns(handle) { return false }
+    handle.value = elem
+    pq.fix(handle.index)
+    return true
+}
+
+// Removes the element of the handle.
+// Returns false if the handle is not in this queue anymore.
+func (pq *PriorityQueue[T]) Remove(handle *PQHandle[T]) bool {
+    if !pq.owns(handle) { return false }
+    pq.removeAt(handle.index)
+    return true
+}
+
+// Returns the k largest elements of the slice according to the comparer,
+// largest first. Uses a heap of size k instead of sorting the whole slice.
+// If a > b then comparer(a, b) > 0
+func TopK[T any] (slice []T, k int, comparer func(T, T) int) []T {
+    if comparer == nil || k <= 0 { return []T{} }
+    pq := NewPriorityQueue(comparer)
+    for _, elem := range slice {
+        if pq.Len() < k {
+            pq.Push(elem)
+        } else if smallest, _ := pq.Peek(); comparer(elem, smallest) > 0 {
+            pq.Pop()
+            pq.Push(elem)
+        }
+    }
+    top := make([]T, 0, pq.Len())
+    for pq.Len() > 0 {
+        elem, _ := pq.Pop()
+        top = append(top, elem)
+    }
+    slices.Reverse(top)
+    return top
+}
+
+func (pq *PriorityQueue[T]) owns(handle *PQHandle[T]) bool {
+    return handle != nil && handle.queue == pq && handle.index >= 0
+}
+
+func (pq *PriorityQueue[T]) removeAt(index int) T {
+    handle := pq.items[index]
+    last := len(pq.items) - 1
+    pq.swap(index, last)
+    pq.items[last] = nil
+    pq.items = pq.items[:last]
+    if index < last {
+        pq.fix(index)
+    }
+    handle.index = -1
+    return handle.value
+}
+
+func (pq *PriorityQueue[T]) fix(index int) {
+    if !pq.down(index) {
+        pq.up(index)
+    }
+}
+
+func (pq *PriorityQueue[T]) up(index int) {
+    for index > 0 {
+        parent := (index - 1) / 2
+        if !pq.less(index, parent) { return }
+        pq.swap(index, parent)
+        index = parent
+    }
+}
+
+// Sifts the element down, returns whether it moved
+func (pq *PriorityQueue[T]) down(index int) bool {
+    start := index
+    for {
+        smallest := index
+        for child := 2 * index + 1; child <= 2 * index + 2; child++ {
+            if child < len(pq.items) && pq.less(child, smallest) {
+                smallest = child
+            }
+        }
+        if smallest == index { return index > start }
+        pq.swap(index, smallest)
+        index = smallest
+    }
+}
+
+func (pq *PriorityQueue[T]) less(i, j int) bool {
+    return pq.comparer(pq.items[i].value, pq.items[j].value) < 0
+}
+
+func (pq *PriorityQueue[T]) swap(i, j int) {
+    pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+    pq.items[i].index = i
+    pq.items[j].index = j
+}
@@ -0,0 +1,73 @@
+package gollections_test
+
+import (
+	"math/rand"
+	"sort"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for priority queue", func() {
+
+    drain := func(pq *PriorityQueue[int]) []int {
+        drained := []int{}
+        for pq.Len() > 0 {
+            elem, _ := pq.Pop()
+            drained = append(drained, elem)
+        }
+        return drained
+    }
+
+    Context("Push() and Pop()", func() {
+        It("Should pop in priority order", func() {
+            minQueue := NewMinPriorityQueue[int]()
+            maxQueue := NewMaxPriorityQueue[int]()
+            for _, elem := range []int{5, 1, 4, 2, 3} {
+                minQueue.Push(elem)
+                maxQueue.Push(elem)
+            }
+            top, _ := minQueue.Peek()
+            Expect(top).Should(Equal(1))
+            Expect(drain(minQueue)).Should(Equal([]int{1, 2, 3, 4, 5}))
+            Expect(drain(maxQueue)).Should(Equal([]int{5, 4, 3, 2, 1}))
+            _, found := minQueue.Pop()
+            Expect(found).Should(BeFalse())
+        })
+
+        It("Should heapify an existing slice", func() {
+            random := rand.New(rand.NewSource(3))
+            slice := make([]int, 200)
+            for i := range slice {
+                slice[i] = random.Intn(1000)
+            }
+            sorted := append([]int{}, slice...)
+            sort.Ints(sorted)
+            Expect(drain(Heapify(slice, func(a, b int) int { return a - b }))).Should(Equal(sorted))
+        })
+    })
+
+    Context("Update() and Remove()", func() {
+        It("Should reorder and remove through handles", func() {
+            pq := NewMinPriorityQueue[int]()
+            handles := Map([]int{10, 20, 30, 40}, pq.Push)
+            Expect(pq.Update(handles[3], 5)).Should(BeTrue())
+            Expect(handles[3].Value()).Should(Equal(5))
+            Expect(pq.Update(handles[0], 50)).Should(BeTrue())
+            Expect(pq.Remove(handles[1])).Should(BeTrue())
+            Expect(pq.Remove(handles[1])).Should(BeFalse())
+            Expect(NewMinPriorityQueue[int]().Update(handles[2], 0)).Should(BeFalse())
+            Expect(drain(pq)).Should(Equal([]int{5, 30, 50}))
+            Expect(pq.Update(handles[0], 1)).Should(BeFalse())
+        })
+    })
+
+    Context("TopK()", func() {
+        It("Should return the k largest elements, largest first", func() {
+            byLength := func(a, b string) int { return len(a) - len(b) }
+            Expect(TopK([]string{"a", "abcd", "ab", "abc", "abcde"}, 3, byLength)).Should(Equal([]string{"abcde", "abcd", "abc"}))
+            Expect(TopK([]int{3, 1, 2}, 5, func(a, b int) int { return a - b })).Should(Equal([]int{3, 2, 1}))
+            Expect(TopK([]int{3, 1, 2}, 0, func(a, b int) int { return a - b })).Should(Equal([]int{}))
+            Expect(TopK([]int{3, 1, 2}, 2, nil)).Should(Equal([]int{}))
+        })
+    })
+})
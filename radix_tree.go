@@ -0,0 +1,187 @@
+package gollections
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Compressed prefix tree keyed by sequences of ordered elements.
+// Children are kept sorted so traversals yield keys in lexicographic order.
+// The zero value is an empty tree ready to use.
+type RadixTree[K constraints.Ordered, V any] struct {
+    root radixNode[K, V]
+    size int
+}
+
+type radixNode[K constraints.Ordered, V any] struct {
+    // Label of the edge leading to this node
+    prefix []K
+    value V
+    hasValue bool
+    // Sorted by the first element of their prefix
+    children []*radixNode[K, V]
+}
+
+// Returns an empty radix tree
+func NewRadixTree[K constraints.Ordered, V any] () *RadixTree[K, V] {
+    return &RadixTree[K, V]{}
+}
+
+// Returns the number of keys in the tree
+func (t *RadixTree[K, V]) Len() int {
+    return t.size
+}
+
+// Sets the value of the key
+func (t *RadixTree[K, V]) Insert(key []K, value V) {
+    n := &t.root
+    for {
+        if len(key) == 0 {
+            if !n.hasValue {
+                t.size++
+            }
+            n.value, n.hasValue = value, true
+            return
+        }
+        index, child := n.child(key[0])
+        if child == nil {
+            leaf := &radixNode[K, V]{prefix: append([]K(nil), key...), value: value, hasValue: true}
+            n.children = append(n.children, nil)
+            copy(n.children[index + 1:], n.children[index:])
+            n.children[index] = leaf
+            t.size++
+            return
+        }
+        common := commonPrefixLen(child.prefix, key)
+        if common < len(child.prefix) {
+            split := &radixNode[K, V]{prefix: child.prefix[:common:common], children: []*radixNode[K, V]{child}}
+            child.prefix = child.prefix[common:]
+            n.children[index] = split
+            child = split
+        }
+        key = key[common:]
+        n = child
+    }
+}
+
+// Returns the value of the key and whether the key is there
+func (t *RadixTree[K, V]) Get(key []K) (V, bool) {
+    n := &t.root
+    for len(key) > 0 {
+        _, child := n.child(key[0])
+        if child == nil || commonPrefixLen(child.prefix, key) < len(child.prefix) {
+            return zero[V](), false
+        }
+        key = key[len(child.prefix):]
+        n = child
+    }
+    return n.value, n.hasValue
+}
+
+// Deletes the key, returns whether it was there
+func (t *RadixTree[K, V]) Delete(key []K) bool {
+    if !t.root.delete(key) { return false }
+    t.size--
+    return true
+}
+
+// Returns the longest key in the tree that is a prefix of the given key,
+// along with its value. Returns false if there is no such key.
+func (t *RadixTree[K, V]) LongestPrefixMatch(key []K) ([]K, V, bool) {
+    n := &t.root
+    matched, bestLen := 0, -1
+    var best *radixNode[K, V]
+    for {
+        if n.hasValue {
+            best, bestLen = n, matched
+        }
+        if matched == len(key) { break }
+        _, child := n.child(key[matched])
+        if child == nil || commonPrefixLen(child.prefix, key[matched:]) < len(child.prefix) { break }
+        matched += len(child.prefix)
+        n = child
+    }
+    if best == nil { return nil, zero[V](), false }
+    return append([]K(nil), key[:bestLen]...), best.value, true
+}
+
+// Runs operation on every key starting with prefix in sorted order,
+// stopping early once operation returns false
+func (t *RadixTree[K, V]) WalkPrefix(prefix []K, operation func([]K, V) bool) {
+    if operation == nil { return }
+    n, path := &t.root, []K{}
+    for len(prefix) > 0 {
+        _, child := n.child(prefix[0])
+        if child == nil { return }
+        common := commonPrefixLen(child.prefix, prefix)
+        if common < len(prefix) && common < len(child.prefix) { return }
+        path = append(path, child.prefix...)
+        prefix = prefix[min(common, len(prefix)):]
+        n = child
+    }
+    n.walk(path, operation)
+}
+
+// Returns every key starting with prefix in sorted order
+func (t *RadixTree[K, V]) KeysWithPrefix(prefix []K) [][]K {
+    return t.Autocomplete(prefix, -1)
+}
+
+// Returns at most limit keys starting with prefix in sorted order.
+// A negative limit returns all of them.
+func (t *RadixTree[K, V]) Autocomplete(prefix []K, limit int) [][]K {
+    keys := [][]K{}
+    if limit == 0 { return keys }
+    t.WalkPrefix(prefix, func(key []K, _ V) bool {
+        keys = append(keys, key)
+        return len(keys) != limit
+    })
+    return keys
+}
+
+// Returns the index of the child starting with first, or where it would be inserted
+func (n *radixNode[K, V]) child(first K) (int, *radixNode[K, V]) {
+    index := sort.Search(len(n.children), func(i int) bool { return n.children[i].prefix[0] >= first })
+    if index < len(n.children) && n.children[index].prefix[0] == first {
+        return index, n.children[index]
+    }
+    return index, nil
+}
+
+func (n *radixNode[K, V]) delete(key []K) bool {
+    if len(key) == 0 {
+        if !n.hasValue { return false }
+        n.value, n.hasValue = zero[V](), false
+        return true
+    }
+    index, child := n.child(key[0])
+    if child == nil || commonPrefixLen(child.prefix, key) < len(child.prefix) { return false }
+    if !child.delete(key[len(child.prefix):]) { return false }
+    switch {
+    case child.hasValue:
+    case len(child.children) == 0:
+        n.children = append(n.children[:index], n.children[index + 1:]...)
+    case len(child.children) == 1:
+        grandchild := child.children[0]
+        grandchild.prefix = append(append([]K(nil), child.prefix...), grandchild.prefix...)
+        n.children[index] = grandchild
+    }
+    return true
+}
+
+func (n *radixNode[K, V]) walk(path []K, operation func([]K, V) bool) bool {
+    if n.hasValue && !operation(append([]K(nil), path...), n.value) { return false }
+    for _, child := range n.children {
+        if !child.walk(append(path, child.prefix...), operation) { return false }
+    }
+    return true
+}
+
+func commonPrefixLen[K comparable] (a, b []K) int {
+    i := 0
+    for i < len(a) && i < len(b) && a[i] == b[i] {
+        i++
+    }
+    return i
+}
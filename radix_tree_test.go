@@ -0,0 +1,50 @@
+package gollections_test
+
+import (
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for radix tree", func() {
+
+    var tree *RadixTree[int, string]
+
+    BeforeEach(func() {
+        tree = NewRadixTree[int, string]()
+        tree.Insert([]int{1, 2, 3}, "a")
+        tree.Insert([]int{1, 2}, "b")
+        tree.Insert([]int{1, 4}, "c")
+        tree.Insert([]int{2}, "d")
+    })
+
+    Context("Insert(), Get() and Delete()", func() {
+        It("Should store sequence keys", func() {
+            Expect(tree.Len()).Should(Equal(4))
+            value, found := tree.Get([]int{1, 2})
+            Expect(value).Should(Equal("b"))
+            Expect(found).Should(BeTrue())
+            _, found = tree.Get([]int{1})
+            Expect(found).Should(BeFalse())
+
+            Expect(tree.Delete([]int{1, 2})).Should(BeTrue())
+            Expect(tree.Delete([]int{1, 2})).Should(BeFalse())
+            Expect(tree.Delete([]int{1})).Should(BeFalse())
+            value, _ = tree.Get([]int{1, 2, 3})
+            Expect(value).Should(Equal("a"))
+            Expect(tree.Len()).Should(Equal(3))
+        })
+    })
+
+    Context("Prefix queries", func() {
+        It("Should find keys by prefix in sorted order", func() {
+            Expect(tree.KeysWithPrefix([]int{1})).Should(Equal([][]int{{1, 2}, {1, 2, 3}, {1, 4}}))
+            Expect(tree.KeysWithPrefix([]int{})).Should(Equal([][]int{{1, 2}, {1, 2, 3}, {1, 4}, {2}}))
+            Expect(tree.Autocomplete([]int{1}, 2)).Should(Equal([][]int{{1, 2}, {1, 2, 3}}))
+            Expect(tree.KeysWithPrefix([]int{3})).Should(Equal([][]int{}))
+
+            key, value, found := tree.LongestPrefixMatch([]int{1, 2, 5})
+            Expect(key).Should(Equal([]int{1, 2}))
+            Expect(value).Should(Equal("b"))
+            Expect(found).Should(BeTrue())
+        })
+    })
+})
@@ -0,0 +1,103 @@
+package gollections
+
+import "iter"
+
+// Fixed capacity FIFO buffer.
+// When full it either rejects new elements or,
+// in overwrite mode, drops the oldest one to make room.
+type RingBuffer[T any] struct {
+    buf []T
+    head, size int
+    overwrite bool
+}
+
+// Returns an empty ring buffer with the given capacity.
+// If overwrite is set pushing into a full buffer drops the oldest element.
+func NewRingBuffer[T any] (capacity int, overwrite bool) *RingBuffer[T] {
+    return &RingBuffer[T]{buf: make([]T, max(capacity, 0)), overwrite: overwrite}
+}
+
+// Returns the number of elements in the buffer
+func (r *RingBuffer[T]) Len() int {
+    return r.size
+}
+
+// Returns the capacity of the buffer
+func (r *RingBuffer[T]) Cap() int {
+    return len(r.buf)
+}
+
+// Returns whether the buffer is at capacity
+func (r *RingBuffer[T]) IsFull() bool {
+    return r.size == len(r.buf)
+}
+
+// Adds the element as the newest one.
+// Returns false if the buffer is full and not in overwrite mode.
+func (r *RingBuffer[T]) Push(elem T) bool {
+    if len(r.buf) == 0 { return false }
+    if r.IsFull() {
+        if !r.overwrite { return false }
+        r.buf[r.head] = elem
+        r.head = (r.head + 1) % len(r.buf)
+        return true
+    }
+    r.buf[(r.head + r.size) % len(r.buf)] = elem
+    r.size++
+    return true
+}
+
+// Removes and returns the oldest element, false if empty
+func (r *RingBuffer[T]) Pop() (T, bool) {
+    if r.size == 0 { return zero[T](), false }
+    elem := r.buf[r.head]
+    r.buf[r.head] = zero[T]()
+    r.head = (r.head + 1) % len(r.buf)
+    r.size--
+    return elem, true
+}
+
+// Returns the oldest element without removing it, false if empty
+func (r *RingBuffer[T]) Peek() (T, bool) {
+    return r.Get(0)
+}
+
+// Returns the newest element without removing it, false if empty
+func (r *RingBuffer[T]) PeekNewest() (T, bool) {
+    return r.Get(r.size - 1)
+}
+
+// Returns the element at index counted from the oldest, false if the index is invalid
+func (r *RingBuffer[T]) Get(index int) (T, bool) {
+    if index < 0 || index >= r.size { return zero[T](), false }
+    return r.buf[(r.head + index) % len(r.buf)], true
+}
+
+// Removes all elements from the buffer
+func (r *RingBuffer[T]) Clear() {
+    clear(r.buf)
+    r.head, r.size = 0, 0
+}
+
+// Returns a sequence over the indices and elements from oldest to newest
+func (r *RingBuffer[T]) All() iter.Seq2[int, T] {
+    return func(yield func(int, T) bool) {
+        for i := 0; i < r.size; i++ {
+            if !yield(i, r.buf[(r.head + i) % len(r.buf)]) { return }
+        }
+    }
+}
+
+// Returns a lazy sequence over the elements from oldest to newest
+func (r *RingBuffer[T]) Lazy() Seq[T] {
+    return func(yield func(T) bool) {
+        for _, elem := range r.All() {
+            if !yield(elem) { return }
+        }
+    }
+}
+
+// Returns the elements from oldest to newest as a new slice
+func (r *RingBuffer[T]) ToSlice() []T {
+    return r.Lazy().Collect()
+}
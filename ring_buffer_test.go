@@ -0,0 +1,43 @@
+package gollections_test
+
+import (
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for ring buffer", func() {
+
+    Context("Push() and Pop()", func() {
+        It("Should reject elements when full", func() {
+            ring := NewRingBuffer[int](2, false)
+            Expect(ring.Push(1)).Should(BeTrue())
+            Expect(ring.Push(2)).Should(BeTrue())
+            Expect(ring.IsFull()).Should(BeTrue())
+            Expect(ring.Push(3)).Should(BeFalse())
+            Expect(ring.ToSlice()).Should(Equal([]int{1, 2}))
+            oldest, _ := ring.Pop()
+            Expect(oldest).Should(Equal(1))
+            Expect(ring.Push(3)).Should(BeTrue())
+            Expect(ring.ToSlice()).Should(Equal([]int{2, 3}))
+            Expect(NewRingBuffer[int](0, true).Push(1)).Should(BeFalse())
+        })
+
+        It("Should keep the last N elements in overwrite mode", func() {
+            ring := NewRingBuffer[int](3, true)
+            for i := 1; i <= 10; i++ {
+                ring.Push(i)
+            }
+            Expect(ring.ToSlice()).Should(Equal([]int{8, 9, 10}))
+            Expect(ring.Len()).Should(Equal(3))
+            Expect(ring.Cap()).Should(Equal(3))
+            oldest, _ := ring.Peek()
+            newest, _ := ring.PeekNewest()
+            Expect([]int{oldest, newest}).Should(Equal([]int{8, 10}))
+            value, _ := ring.Get(1)
+            Expect(value).Should(Equal(9))
+            Expect(ring.Lazy().Take(2).Collect()).Should(Equal([]int{8, 9}))
+            ring.Clear()
+            _, found := ring.Pop()
+            Expect(found).Should(BeFalse())
+        })
+    })
+})
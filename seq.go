@@ -0,0 +1,151 @@
+package gollections
+
+import (
+	"errors"
+	"iter"
+)
+
+// Lazy sequence of elements.
+// Nothing is evaluated until the sequence is consumed,
+// and consumers stop pulling elements as soon as they are done.
+type Seq[T any] iter.Seq[T]
+
+// Returns a lazy sequence over the elements of the slice
+func Lazy[T any] (slice []T) Seq[T] {
+    return func(yield func(T) bool) {
+        for _, elem := range slice {
+            if !yield(elem) { return }
+        }
+    }
+}
+
+// Returns a lazy sequence over the elements of the slice in reversed order
+func LazyReversed[T any] (slice []T) Seq[T] {
+    return func(yield func(T) bool) {
+        for i := len(slice) - 1; i >= 0; i-- {
+            if !yield(slice[i]) { return }
+        }
+    }
+}
+
+// Returns a sequence over the elements of a standard library iterator,
+// such as the ones from slices.Values or maps.Keys
+func FromIter[T any] (seq iter.Seq[T]) Seq[T] {
+    return Seq[T](seq)
+}
+
+// Returns the sequence as a standard library iterator,
+// so it can be passed to functions like slices.Collect
+func (seq Seq[T]) Iter() iter.Seq[T] {
+    return iter.Seq[T](seq)
+}
+
+// Returns a sequence of the elements that satisfy the given predicate
+func (seq Seq[T]) Filter(predicate func(T) bool) Seq[T] {
+    return func(yield func(T) bool) {
+        if seq == nil || predicate == nil { return }
+        for elem := range seq {
+            if predicate(elem) && !yield(elem) { return }
+        }
+    }
+}
+
+// Returns a sequence of at most the first n elements
+func (seq Seq[T]) Take(n int) Seq[T] {
+    return func(yield func(T) bool) {
+        if seq == nil || n <= 0 { return }
+        taken := 0
+        for elem := range seq {
+            if !yield(elem) { return }
+            if taken++; taken == n { return }
+        }
+    }
+}
+
+// Returns a sequence without the first n elements
+func (seq Seq[T]) Skip(n int) Seq[T] {
+    return func(yield func(T) bool) {
+        if seq == nil { return }
+        skipped := 0
+        for elem := range seq {
+            if skipped < n {
+                skipped++
+                continue
+            }
+            if !yield(elem) { return }
+        }
+    }
+}
+
+// Returns a sequence of the elements before the first one not satisfying the predicate
+func (seq Seq[T]) TakeWhile(predicate func(T) bool) Seq[T] {
+    return func(yield func(T) bool) {
+        if seq == nil || predicate == nil { return }
+        for elem := range seq {
+            if !predicate(elem) || !yield(elem) { return }
+        }
+    }
+}
+
+// Returns a sequence of the elements starting from the first one not satisfying the predicate
+func (seq Seq[T]) SkipWhile(predicate func(T) bool) Seq[T] {
+    return func(yield func(T) bool) {
+        if seq == nil || predicate == nil { return }
+        skipping := true
+        for elem := range seq {
+            if skipping && predicate(elem) { continue }
+            skipping = false
+            if !yield(elem) { return }
+        }
+    }
+}
+
+// Returns the first element of the sequence.
+// Raises error if the sequence is empty
+func (seq Seq[T]) First() (T, error) {
+    if seq != nil {
+        for elem := range seq {
+            return elem, nil
+        }
+    }
+    return zero[T](), errors.New("Sequence is empty")
+}
+
+// Performs the given operation for each element of the sequence
+func (seq Seq[T]) ForEach(operation func(T)) {
+    if seq == nil || operation == nil { return }
+    for elem := range seq {
+        operation(elem)
+    }
+}
+
+// Consumes the sequence and returns its elements as a slice
+func (seq Seq[T]) Collect() []T {
+    collected := []T{}
+    if seq == nil { return collected }
+    for elem := range seq {
+        collected = append(collected, elem)
+    }
+    return collected
+}
+
+// Returns a sequence of the transformed values of the given sequence
+func MapSeq[T any, R any] (seq Seq[T], transform func(T) R) Seq[R] {
+    return func(yield func(R) bool) {
+        if seq == nil || transform == nil { return }
+        for elem := range seq {
+            if !yield(transform(elem)) { return }
+        }
+    }
+}
+
+// Accumulates value starting with the given initial
+// by performing operation on the sequence in order
+func FoldSeq[T any, R any] (seq Seq[T], initial R, operation func(T, R) R) R {
+    accumulator := initial
+    if seq == nil || operation == nil { return accumulator }
+    for elem := range seq {
+        accumulator = operation(elem, accumulator)
+    }
+    return accumulator
+}
@@ -0,0 +1,103 @@
+package gollections_test
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for lazy sequences", func() {
+
+    var list []int
+
+    BeforeEach(func() {
+        list = []int{1, 2, 3, 4, 5}
+    })
+
+    Context("Lazy()", func() {
+        It("Should iterate over the slice in order", func() {
+            Expect(Lazy(list).Collect()).Should(Equal(list))
+            Expect(Lazy[int](nil).Collect()).Should(Equal([]int{}))
+            Expect(LazyReversed(list).Collect()).Should(Equal([]int{5, 4, 3, 2, 1}))
+        })
+    })
+
+    Context("FromIter() and Iter()", func() {
+        It("Should interoperate with standard library iterators", func() {
+            Expect(slices.Collect(Lazy(list).Filter(func(x int) bool { return x > 3 }).Iter())).Should(Equal([]int{4, 5}))
+            Expect(FromIter(slices.Values(list)).Skip(3).Collect()).Should(Equal([]int{4, 5}))
+            keys := FromIter(maps.Keys(map[string]int{"a": 1, "b": 2})).Collect()
+            slices.Sort(keys)
+            Expect(keys).Should(Equal([]string{"a", "b"}))
+        })
+    })
+
+    Context("Filter()", func() {
+        It("Should filter lazily as per predicate", func() {
+            Expect(Lazy(list).Filter(func(x int) bool { return x % 2 == 1 }).Collect()).Should(Equal([]int{1, 3, 5}))
+            Expect(Lazy(list).Filter(nil).Collect()).Should(Equal([]int{}))
+        })
+    })
+
+    Context("Take() and Skip()", func() {
+        It("Should take and skip elements", func() {
+            Expect(Lazy(list).Take(2).Collect()).Should(Equal([]int{1, 2}))
+            Expect(Lazy(list).Take(0).Collect()).Should(Equal([]int{}))
+            Expect(Lazy(list).Take(10).Collect()).Should(Equal(list))
+            Expect(Lazy(list).Skip(3).Collect()).Should(Equal([]int{4, 5}))
+            Expect(Lazy(list).Skip(-1).Collect()).Should(Equal(list))
+            Expect(Lazy(list).Skip(1).Take(2).Collect()).Should(Equal([]int{2, 3}))
+        })
+    })
+
+    Context("TakeWhile() and SkipWhile()", func() {
+        It("Should take and skip while the predicate holds", func() {
+            small := func(x int) bool { return x < 3 }
+            Expect(Lazy(list).TakeWhile(small).Collect()).Should(Equal([]int{1, 2}))
+            Expect(Lazy(list).SkipWhile(small).Collect()).Should(Equal([]int{3, 4, 5}))
+            Expect(Lazy([]int{1, 5, 1}).SkipWhile(small).Collect()).Should(Equal([]int{5, 1}))
+            Expect(Lazy(list).TakeWhile(nil).Collect()).Should(Equal([]int{}))
+        })
+    })
+
+    Context("First()", func() {
+        It("Should return the first element, error if empty", func() {
+            Expect(Lazy(list).Skip(2).First()).Should(Equal(3))
+            _, err := Lazy(list).Skip(5).First()
+            Expect(err).ShouldNot(BeNil())
+        })
+    })
+
+    Context("MapSeq()", func() {
+        It("Should transform lazily and short circuit", func() {
+            calls := 0
+            square := func(x int) int {
+                calls++
+                return x * x
+            }
+            first, err := MapSeq(Lazy(list).Filter(func(x int) bool { return x > 1 }), square).First()
+            Expect(first).Should(Equal(4))
+            Expect(err).Should(BeNil())
+            Expect(calls).Should(Equal(1))
+            Expect(MapSeq(Lazy(list), func(x int) string { return fmt.Sprint(x) }).Collect()).Should(Equal([]string{"1", "2", "3", "4", "5"}))
+            Expect(MapSeq[int, int](Lazy(list), nil).Collect()).Should(Equal([]int{}))
+        })
+    })
+
+    Context("FoldSeq()", func() {
+        It("Should reduce the sequence as per the operation", func() {
+            Expect(FoldSeq(Lazy(list).Take(3), 0, func(a, b int) int { return a + b })).Should(Equal(6))
+            Expect(FoldSeq(Lazy(list), 0, nil)).Should(Equal(0))
+        })
+    })
+
+    Context("ForEach()", func() {
+        It("Should run the operation on each element", func() {
+            sum := 0
+            Lazy(list).ForEach(func(x int) { sum += x })
+            Expect(sum).Should(Equal(15))
+        })
+    })
+})
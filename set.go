@@ -0,0 +1,156 @@
+package gollections
+
+import (
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Set of unique elements backed by a map.
+// Being a map it also works with the map utilities like Keys and ContainsKey.
+type Set[T comparable] map[T]struct{}
+
+// Returns a new set containing the given elements
+func NewSet[T comparable] (elems ...T) Set[T] {
+    set := make(Set[T], len(elems))
+    set.Add(elems...)
+    return set
+}
+
+// Adds the given elements to the set
+func (set Set[T]) Add(elems ...T) {
+    for _, elem := range elems {
+        set[elem] = struct{}{}
+    }
+}
+
+// Removes the given elements from the set
+func (set Set[T]) Remove(elems ...T) {
+    for _, elem := range elems {
+        delete(set, elem)
+    }
+}
+
+// Returns whether the set contains the element
+func (set Set[T]) Has(elem T) bool {
+    _, found := set[elem]
+    return found
+}
+
+// Returns the number of elements in the set
+func (set Set[T]) Len() int {
+    return len(set)
+}
+
+// Returns a copy of the set
+func (set Set[T]) Clone() Set[T] {
+    clone := make(Set[T], len(set))
+    for elem := range set {
+        clone[elem] = struct{}{}
+    }
+    return clone
+}
+
+// Returns a new set with the elements that are in either set
+func (set Set[T]) Union(other Set[T]) Set[T] {
+    union := set.Clone()
+    for elem := range other {
+        union[elem] = struct{}{}
+    }
+    return union
+}
+
+// Returns a new set with the elements that are in both sets
+func (set Set[T]) Intersect(other Set[T]) Set[T] {
+    smaller, larger := set, other
+    if len(smaller) > len(larger) {
+        smaller, larger = larger, smaller
+    }
+    return smaller.Filter(larger.Has)
+}
+
+// Returns a new set with the elements of this set that are not in other
+func (set Set[T]) Difference(other Set[T]) Set[T] {
+    return set.Filter(func(elem T) bool { return !other.Has(elem) })
+}
+
+// Returns a new set with the elements that are in exactly one of the sets
+func (set Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+    difference := set.Difference(other)
+    for elem := range other {
+        if !set.Has(elem) {
+            difference[elem] = struct{}{}
+        }
+    }
+    return difference
+}
+
+// Returns whether every element of this set is in other
+func (set Set[T]) IsSubset(other Set[T]) bool {
+    if len(set) > len(other) { return false }
+    for elem := range set {
+        if !other.Has(elem) { return false }
+    }
+    return true
+}
+
+// Returns whether every element of other is in this set
+func (set Set[T]) IsSuperset(other Set[T]) bool {
+    return other.IsSubset(set)
+}
+
+// Returns whether both sets contain the same elements
+func (set Set[T]) Equal(other Set[T]) bool {
+    return len(set) == len(other) && set.IsSubset(other)
+}
+
+// Returns a new set with the elements that satisfy the given predicate
+func (set Set[T]) Filter(predicate func(T) bool) Set[T] {
+    filtered := make(Set[T])
+    if predicate == nil { return filtered }
+    for elem := range set {
+        if predicate(elem) {
+            filtered[elem] = struct{}{}
+        }
+    }
+    return filtered
+}
+
+// Performs the given operation for each element in the set
+func (set Set[T]) ForEach(operation func(T)) {
+    if operation == nil { return }
+    for elem := range set {
+        operation(elem)
+    }
+}
+
+// Returns a lazy sequence over the elements of the set
+func (set Set[T]) Lazy() Seq[T] {
+    return func(yield func(T) bool) {
+        for elem := range set {
+            if !yield(elem) { return }
+        }
+    }
+}
+
+// Returns the elements of the set as a slice in no particular order
+func (set Set[T]) ToSlice() []T {
+    return Keys(set)
+}
+
+// Returns a set of the transformed values of the elements in the set
+func MapSet[T, R comparable] (set Set[T], transform func(T) R) Set[R] {
+    transformed := make(Set[R])
+    if transform == nil { return transformed }
+    for elem := range set {
+        transformed[transform(elem)] = struct{}{}
+    }
+    return transformed
+}
+
+// Returns the elements of the set as a slice in ascending order
+func SortedElements[T constraints.Ordered] (set Set[T]) []T {
+    elems := set.ToSlice()
+    slices.Sort(elems)
+    return elems
+}
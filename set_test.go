@@ -0,0 +1,61 @@
+package gollections_test
+
+import (
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for set", func() {
+
+    var set Set[int]
+
+    BeforeEach(func() {
+        set = NewSet(1, 2, 3, 4)
+    })
+
+    Context("Add(), Remove(), Has() and Len()", func() {
+        It("Should manage membership", func() {
+            Expect(set.Len()).Should(Equal(4))
+            set.Add(4, 5)
+            Expect(set.Len()).Should(Equal(5))
+            Expect(set.Has(5)).Should(BeTrue())
+            set.Remove(1, 10)
+            Expect(set.Has(1)).Should(BeFalse())
+            Expect(set.Len()).Should(Equal(4))
+            Expect(ContainsKey(set, 2)).Should(BeTrue())
+        })
+    })
+
+    Context("Set algebra", func() {
+        It("Should compute union, intersection and differences", func() {
+            other := NewSet(3, 4, 5, 6)
+            Expect(SortedElements(set.Union(other))).Should(Equal([]int{1, 2, 3, 4, 5, 6}))
+            Expect(SortedElements(set.Intersect(other))).Should(Equal([]int{3, 4}))
+            Expect(SortedElements(set.Difference(other))).Should(Equal([]int{1, 2}))
+            Expect(SortedElements(set.SymmetricDifference(other))).Should(Equal([]int{1, 2, 5, 6}))
+            Expect(SortedElements(set)).Should(Equal([]int{1, 2, 3, 4}))
+        })
+    })
+
+    Context("Set comparisons", func() {
+        It("Should check subsets, supersets and equality", func() {
+            Expect(NewSet(1, 2).IsSubset(set)).Should(BeTrue())
+            Expect(NewSet(1, 7).IsSubset(set)).Should(BeFalse())
+            Expect(set.IsSuperset(NewSet[int]())).Should(BeTrue())
+            Expect(set.Equal(NewSet(4, 3, 2, 1))).Should(BeTrue())
+            Expect(set.Equal(NewSet(1, 2, 3))).Should(BeFalse())
+        })
+    })
+
+    Context("Integration with list utilities", func() {
+        It("Should filter, map and iterate", func() {
+            Expect(SortedElements(set.Filter(func(x int) bool { return x % 2 == 0 }))).Should(Equal([]int{2, 4}))
+            Expect(set.Filter(nil).Len()).Should(Equal(0))
+            Expect(MapSet(set, func(x int) bool { return x > 2 }).Equal(NewSet(true, false))).Should(BeTrue())
+            Expect(Fold(set.ToSlice(), 0, func(a, b int) int { return a + b })).Should(Equal(10))
+            Expect(FoldSeq(set.Lazy(), 0, func(a, b int) int { return a + b })).Should(Equal(10))
+            sum := 0
+            set.ForEach(func(x int) { sum += x })
+            Expect(sum).Should(Equal(10))
+        })
+    })
+})
@@ -0,0 +1,66 @@
+package gollections
+
+// Returns the first n elements, or all of them if there are fewer than n
+func Take[T any] (slice []T, n int) []T {
+    return append([]T{}, slice[:clamp(n, 0, len(slice))]...)
+}
+
+// Returns the last n elements, or all of them if there are fewer than n
+func TakeLast[T any] (slice []T, n int) []T {
+    return append([]T{}, slice[len(slice) - clamp(n, 0, len(slice)):]...)
+}
+
+// Returns the elements before the first one not satisfying the predicate
+func TakeWhile[T any] (slice []T, predicate func(T) bool) []T {
+    return Lazy(slice).TakeWhile(predicate).Collect()
+}
+
+// Returns the elements without the first n
+func Skip[T any] (slice []T, n int) []T {
+    return append([]T{}, slice[clamp(n, 0, len(slice)):]...)
+}
+
+// Returns the elements without the last n
+func SkipLast[T any] (slice []T, n int) []T {
+    return append([]T{}, slice[:len(slice) - clamp(n, 0, len(slice))]...)
+}
+
+// Returns the elements starting from the first one not satisfying the predicate
+func SkipWhile[T any] (slice []T, predicate func(T) bool) []T {
+    return Lazy(slice).SkipWhile(predicate).Collect()
+}
+
+// Drops the elements from index `from` up to but not including `to` and returns the rest.
+// Indices are clamped to the slice. The original slice remains unchanged.
+func DropRange[T any] (slice []T, from, to int) []T {
+    from, to = clamp(from, 0, len(slice)), clamp(to, 0, len(slice))
+    if from >= to { return append([]T{}, slice...) }
+    return append(append([]T{}, slice[:from]...), slice[to:]...)
+}
+
+// Returns every step-th element from index start up to but not including end, like slice[start:end:step] in Python.
+// Negative indices count from the end, and a negative step walks backwards.
+// Out of range indices are clamped, so an end of -len(slice)-1 or less with a negative step reaches the first element.
+// Returns an empty slice if step is 0.
+func Slice[T any] (slice []T, start, end, step int) []T {
+    sliced := []T{}
+    if step == 0 { return sliced }
+    start, end = sliceIndex(start, len(slice), step), sliceIndex(end, len(slice), step)
+    for i := start; (step > 0 && i < end) || (step < 0 && i > end); i += step {
+        sliced = append(sliced, slice[i])
+    }
+    return sliced
+}
+
+// Resolves a Python style index, clamping it to -1 or len - 1 when walking backwards
+func sliceIndex(index, length, step int) int {
+    if index < 0 {
+        index += length
+    }
+    if step < 0 { return clamp(index, -1, length - 1) }
+    return clamp(index, 0, length)
+}
+
+func clamp(value, low, high int) int {
+    return max(low, min(value, high))
+}
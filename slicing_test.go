@@ -0,0 +1,78 @@
+package gollections_test
+
+import (
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for slicing", func() {
+
+    list := []int{0, 1, 2, 3, 4, 5}
+    small := func(x int) bool { return x < 3 }
+
+    Context("Take() and TakeLast()", func() {
+        It("Should take elements from either end", func() {
+            Expect(Take(list, 2)).Should(Equal([]int{0, 1}))
+            Expect(Take(list, 10)).Should(Equal(list))
+            Expect(Take(list, -1)).Should(Equal([]int{}))
+            Expect(TakeLast(list, 2)).Should(Equal([]int{4, 5}))
+            Expect(TakeLast(list, 10)).Should(Equal(list))
+            Expect(TakeLast([]int{}, 2)).Should(Equal([]int{}))
+        })
+    })
+
+    Context("Skip() and SkipLast()", func() {
+        It("Should skip elements from either end", func() {
+            Expect(Skip(list, 4)).Should(Equal([]int{4, 5}))
+            Expect(Skip(list, 10)).Should(Equal([]int{}))
+            Expect(Skip(list, -2)).Should(Equal(list))
+            Expect(SkipLast(list, 4)).Should(Equal([]int{0, 1}))
+            Expect(SkipLast(list, 10)).Should(Equal([]int{}))
+        })
+
+        It("Should return copies of the slice", func() {
+            taken := Take(list, 2)
+            taken[0] = 100
+            Expect(list[0]).Should(Equal(0))
+        })
+    })
+
+    Context("TakeWhile() and SkipWhile()", func() {
+        It("Should split at the first element not satisfying predicate", func() {
+            Expect(TakeWhile(list, small)).Should(Equal([]int{0, 1, 2}))
+            Expect(SkipWhile(list, small)).Should(Equal([]int{3, 4, 5}))
+            Expect(TakeWhile(list, nil)).Should(Equal([]int{}))
+            Expect(SkipWhile(list, nil)).Should(Equal([]int{}))
+        })
+    })
+
+    Context("DropRange()", func() {
+        It("Should drop the half open range with clamping", func() {
+            Expect(DropRange(list, 1, 3)).Should(Equal([]int{0, 3, 4, 5}))
+            Expect(DropRange(list, -5, 2)).Should(Equal([]int{2, 3, 4, 5}))
+            Expect(DropRange(list, 4, 100)).Should(Equal([]int{0, 1, 2, 3}))
+            Expect(DropRange(list, 3, 3)).Should(Equal(list))
+            Expect(DropRange(list, 4, 1)).Should(Equal(list))
+            Expect(list).Should(Equal([]int{0, 1, 2, 3, 4, 5}))
+        })
+    })
+
+    Context("Slice()", func() {
+        It("Should slice like Python", func() {
+            Expect(Slice(list, 1, 5, 2)).Should(Equal([]int{1, 3}))
+            Expect(Slice(list, -3, 6, 1)).Should(Equal([]int{3, 4, 5}))
+            Expect(Slice(list, 0, -1, 1)).Should(Equal([]int{0, 1, 2, 3, 4}))
+            Expect(Slice(list, -1, -7, -1)).Should(Equal([]int{5, 4, 3, 2, 1, 0}))
+            Expect(Slice(list, 4, 1, -2)).Should(Equal([]int{4, 2}))
+            Expect(Slice(list, 4, 1, 1)).Should(Equal([]int{}))
+            Expect(Slice(list, 1, 4, 0)).Should(Equal([]int{}))
+        })
+
+        It("Should clamp out of range indices", func() {
+            Expect(Slice(list, -100, 100, 1)).Should(Equal(list))
+            Expect(Slice(list, 100, -100, -2)).Should(Equal([]int{5, 3, 1}))
+            Expect(Slice(list, 10, 20, 1)).Should(Equal([]int{}))
+            Expect(Slice([]int{}, 0, 5, 1)).Should(Equal([]int{}))
+            Expect(Slice([]int{}, 5, -5, -1)).Should(Equal([]int{}))
+        })
+    })
+})
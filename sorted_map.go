@@ -0,0 +1,338 @@
+package gollections
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Map that keeps its keys sorted, backed by an AVL tree.
+// Lookups, updates, rank and select run in O(log n).
+type SortedMap[K any, V any] struct {
+    root *sortedNode[K, V]
+    comparer func(K, K) int
+}
+
+type sortedNode[K any, V any] struct {
+    key K
+    value V
+    left, right *sortedNode[K, V]
+    height, size int
+}
+
+// Returns an empty sorted map ordered by the natural order of the keys
+func NewSortedMap[K constraints.Ordered, V any] () *SortedMap[K, V] {
+    return NewSortedMapFunc[K, V](compareOrdered[K])
+}
+
+// Returns an empty sorted map ordered by the comparer function.
+// If a > b then comparer(a, b) > 0
+func NewSortedMapFunc[K any, V any] (comparer func(K, K) int) *SortedMap[K, V] {
+    return &SortedMap[K, V]{comparer: comparer}
+}
+
+// Returns the number of entries in the map
+func (m *SortedMap[K, V]) Len() int {
+    return nodeSize(m.root)
+}
+
+// Sets the value of the key
+func (m *SortedMap[K, V]) Put(key K, value V) {
+    m.root = m.put(m.root, key, value)
+}
+
+// Returns the value of the key and whether the key is there
+func (m *SortedMap[K, V]) Get(key K) (V, bool) {
+    for n := m.root; n != nil; {
+        c := m.comparer(key, n.key)
+        switch {
+        case c < 0:
+            n = n.left
+        case c > 0:
+            n = n.right
+        default:
+            return n.value, true
+        }
+    }
+    return zero[V](), false
+}
+
+// Get the value corresponding to the key, returns default value if the key is not there.
+func (m *SortedMap[K, V]) GetOrDefault(key K, defaultVal V) V {
+    if value, found := m.Get(key); found {
+        return value
+    }
+    return defaultVal
+}
+
+// Returns whether the map contains the key
+func (m *SortedMap[K, V]) ContainsKey(key K) bool {
+    _, found := m.Get(key)
+    return found
+}
+
+// Deletes the key, returns whether it was there
+func (m *SortedMap[K, V]) Delete(key K) bool {
+    var deleted bool
+    m.root, deleted = m.delete(m.root, key)
+    return deleted
+}
+
+// Returns the entry with the smallest key, false if the map is empty
+func (m *SortedMap[K, V]) Min() (*Pair[K, V], bool) {
+    if m.root == nil { return nil, false }
+    n := m.root
+    for n.left != nil {
+        n = n.left
+    }
+    return n.entry(), true
+}
+
+// Returns the entry with the largest key, false if the map is empty
+func (m *SortedMap[K, V]) Max() (*Pair[K, V], bool) {
+    if m.root == nil { return nil, false }
+    n := m.root
+    for n.right != nil {
+        n = n.right
+    }
+    return n.entry(), true
+}
+
+// Returns the entry with the largest key less than or equal to key
+func (m *SortedMap[K, V]) Floor(key K) (*Pair[K, V], bool) {
+    return m.below(key, true)
+}
+
+// Returns the entry with the largest key strictly less than key
+func (m *SortedMap[K, V]) Lower(key K) (*Pair[K, V], bool) {
+    return m.below(key, false)
+}
+
+// Returns the entry with the smallest key greater than or equal to key
+func (m *SortedMap[K, V]) Ceiling(key K) (*Pair[K, V], bool) {
+    return m.above(key, true)
+}
+
+// Returns the entry with the smallest key strictly greater than key
+func (m *SortedMap[K, V]) Higher(key K) (*Pair[K, V], bool) {
+    return m.above(key, false)
+}
+
+// Returns the number of keys strictly less than key
+func (m *SortedMap[K, V]) Rank(key K) int {
+    rank := 0
+    for n := m.root; n != nil; {
+        if m.comparer(key, n.key) <= 0 {
+            n = n.left
+        } else {
+            rank += nodeSize(n.left) + 1
+            n = n.right
+        }
+    }
+    return rank
+}
+
+// Returns the entry with the given zero based rank, false if out of range
+func (m *SortedMap[K, V]) Select(rank int) (*Pair[K, V], bool) {
+    if rank < 0 || rank >= m.Len() { return nil, false }
+    n := m.root
+    for {
+        leftSize := nodeSize(n.left)
+        switch {
+        case rank < leftSize:
+            n = n.left
+        case rank > leftSize:
+            rank -= leftSize + 1
+            n = n.right
+        default:
+            return n.entry(), true
+        }
+    }
+}
+
+// Returns a sequence over all entries in ascending key order
+func (m *SortedMap[K, V]) All() iter.Seq2[K, V] {
+    return func(yield func(K, V) bool) {
+        m.walk(m.root, nil, nil, yield)
+    }
+}
+
+// Returns a sequence over the entries with from <= key < to in ascending key order
+func (m *SortedMap[K, V]) Range(from, to K) iter.Seq2[K, V] {
+    return func(yield func(K, V) bool) {
+        m.walk(m.root, &from, &to, yield)
+    }
+}
+
+// Returns the slice of all keys in ascending order
+func (m *SortedMap[K, V]) Keys() []K {
+    keys := make([]K, 0, m.Len())
+    for key := range m.All() {
+        keys = append(keys, key)
+    }
+    return keys
+}
+
+// Returns the slice of all values in ascending key order
+func (m *SortedMap[K, V]) Values() []V {
+    values := make([]V, 0, m.Len())
+    for _, value := range m.All() {
+        values = append(values, value)
+    }
+    return values
+}
+
+// Returns a slice key, value pair in ascending key order
+func (m *SortedMap[K, V]) Entries() []*Pair[K, V] {
+    entries := make([]*Pair[K, V], 0, m.Len())
+    for key, value := range m.All() {
+        entries = append(entries, &Pair[K, V]{key, value})
+    }
+    return entries
+}
+
+// Runs operation on each entry of the map in ascending key order
+func (m *SortedMap[K, V]) ForEachEntry(operation func(K, V)) {
+    if operation == nil { return }
+    for key, value := range m.All() {
+        operation(key, value)
+    }
+}
+
+func (m *SortedMap[K, V]) below(key K, inclusive bool) (*Pair[K, V], bool) {
+    var best *sortedNode[K, V]
+    for n := m.root; n != nil; {
+        c := m.comparer(n.key, key)
+        if c < 0 || (inclusive && c == 0) {
+            best = n
+            n = n.right
+        } else {
+            n = n.left
+        }
+    }
+    if best == nil { return nil, false }
+    return best.entry(), true
+}
+
+func (m *SortedMap[K, V]) above(key K, inclusive bool) (*Pair[K, V], bool) {
+    var best *sortedNode[K, V]
+    for n := m.root; n != nil; {
+        c := m.comparer(n.key, key)
+        if c > 0 || (inclusive && c == 0) {
+            best = n
+            n = n.left
+        } else {
+            n = n.right
+        }
+    }
+    if best == nil { return nil, false }
+    return best.entry(), true
+}
+
+// In order traversal of the keys within the optional bounds [from, to).
+// Returns false once yield asks to stop.
+func (m *SortedMap[K, V]) walk(n *sortedNode[K, V], from, to *K, yield func(K, V) bool) bool {
+    if n == nil { return true }
+    aboveFrom := from == nil || m.comparer(n.key, *from) >= 0
+    belowTo := to == nil || m.comparer(n.key, *to) < 0
+    if aboveFrom && !m.walk(n.left, from, to, yield) { return false }
+    if aboveFrom && belowTo && !yield(n.key, n.value) { return false }
+    if belowTo { return m.walk(n.right, from, to, yield) }
+    return true
+}
+
+func (m *SortedMap[K, V]) put(n *sortedNode[K, V], key K, value V) *sortedNode[K, V] {
+    if n == nil {
+        return &sortedNode[K, V]{key: key, value: value, height: 1, size: 1}
+    }
+    c := m.comparer(key, n.key)
+    switch {
+    case c < 0:
+        n.left = m.put(n.left, key, value)
+    case c > 0:
+        n.right = m.put(n.right, key, value)
+    default:
+        n.value = value
+        return n
+    }
+    return rebalance(n)
+}
+
+func (m *SortedMap[K, V]) delete(n *sortedNode[K, V], key K) (*sortedNode[K, V], bool) {
+    if n == nil { return nil, false }
+    var deleted bool
+    c := m.comparer(key, n.key)
+    switch {
+    case c < 0:
+        n.left, deleted = m.delete(n.left, key)
+    case c > 0:
+        n.right, deleted = m.delete(n.right, key)
+    default:
+        if n.left == nil { return n.right, true }
+        if n.right == nil { return n.left, true }
+        var successor *sortedNode[K, V]
+        n.right, successor = deleteMin(n.right)
+        successor.left, successor.right = n.left, n.right
+        return rebalance(successor), true
+    }
+    return rebalance(n), deleted
+}
+
+func deleteMin[K any, V any] (n *sortedNode[K, V]) (*sortedNode[K, V], *sortedNode[K, V]) {
+    if n.left == nil { return n.right, n }
+    var minNode *sortedNode[K, V]
+    n.left, minNode = deleteMin(n.left)
+    return rebalance(n), minNode
+}
+
+func rebalance[K any, V any] (n *sortedNode[K, V]) *sortedNode[K, V] {
+    n.update()
+    switch balance := nodeHeight(n.left) - nodeHeight(n.right); {
+    case balance > 1:
+        if nodeHeight(n.left.left) < nodeHeight(n.left.right) {
+            n.left = rotateLeft(n.left)
+        }
+        return rotateRight(n)
+    case balance < -1:
+        if nodeHeight(n.right.right) < nodeHeight(n.right.left) {
+            n.right = rotateRight(n.right)
+        }
+        return rotateLeft(n)
+    }
+    return n
+}
+
+func rotateLeft[K any, V any] (n *sortedNode[K, V]) *sortedNode[K, V] {
+    pivot := n.right
+    n.right, pivot.left = pivot.left, n
+    n.update()
+    pivot.update()
+    return pivot
+}
+
+func rotateRight[K any, V any] (n *sortedNode[K, V]) *sortedNode[K, V] {
+    pivot := n.left
+    n.left, pivot.right = pivot.right, n
+    n.update()
+    pivot.update()
+    return pivot
+}
+
+func (n *sortedNode[K, V]) update() {
+    n.height = 1 + max(nodeHeight(n.left), nodeHeight(n.right))
+    n.size = 1 + nodeSize(n.left) + nodeSize(n.right)
+}
+
+func (n *sortedNode[K, V]) entry() *Pair[K, V] {
+    return &Pair[K, V]{n.key, n.value}
+}
+
+func nodeHeight[K any, V any] (n *sortedNode[K, V]) int {
+    if n == nil { return 0 }
+    return n.height
+}
+
+func nodeSize[K any, V any] (n *sortedNode[K, V]) int {
+    if n == nil { return 0 }
+    return n.size
+}
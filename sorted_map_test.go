@@ -0,0 +1,145 @@
+package gollections_test
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for sorted map", func() {
+
+    var sortedMap *SortedMap[int, string]
+
+    BeforeEach(func() {
+        sortedMap = NewSortedMap[int, string]()
+        for _, key := range []int{50, 20, 80, 10, 30, 70, 90} {
+            sortedMap.Put(key, strings.Repeat("x", key / 10))
+        }
+    })
+
+    Context("Put(), Get() and Delete()", func() {
+        It("Should keep keys sorted", func() {
+            Expect(sortedMap.Keys()).Should(Equal([]int{10, 20, 30, 50, 70, 80, 90}))
+            sortedMap.Put(50, "y")
+            value, found := sortedMap.Get(50)
+            Expect(value).Should(Equal("y"))
+            Expect(found).Should(BeTrue())
+            Expect(sortedMap.GetOrDefault(60, "none")).Should(Equal("none"))
+            Expect(sortedMap.Delete(50)).Should(BeTrue())
+            Expect(sortedMap.Delete(50)).Should(BeFalse())
+            Expect(sortedMap.ContainsKey(50)).Should(BeFalse())
+            Expect(sortedMap.Len()).Should(Equal(6))
+        })
+
+        It("Should stay consistent under random operations", func() {
+            random := rand.New(rand.NewSource(42))
+            treeMap := NewSortedMap[int, int]()
+            reference := map[int]int{}
+            for i := 0; i < 2000; i++ {
+                key := random.Intn(300)
+                if random.Intn(3) == 0 {
+                    Expect(treeMap.Delete(key)).Should(Equal(ContainsKey(reference, key)))
+                    delete(reference, key)
+                } else {
+                    treeMap.Put(key, i)
+                    reference[key] = i
+                }
+            }
+            keys := Keys(reference)
+            sort.Ints(keys)
+            Expect(treeMap.Keys()).Should(Equal(keys))
+            Expect(treeMap.Len()).Should(Equal(len(reference)))
+            for rank, key := range keys {
+                Expect(treeMap.Rank(key)).Should(Equal(rank))
+                entry, _ := treeMap.Select(rank)
+                Expect(entry).Should(Equal(&Pair[int, int]{key, reference[key]}))
+            }
+        })
+
+        It("Should keep NaN apart from other float keys", func() {
+            floatMap := NewSortedMap[float64, string]()
+            floatMap.Put(1, "one")
+            floatMap.Put(math.NaN(), "nan")
+            floatMap.Put(-1, "minus one")
+            Expect(floatMap.Len()).Should(Equal(3))
+            value, _ := floatMap.Get(1)
+            Expect(value).Should(Equal("one"))
+            value, found := floatMap.Get(math.NaN())
+            Expect(value).Should(Equal("nan"))
+            Expect(found).Should(BeTrue())
+            first, _ := floatMap.Min()
+            Expect(math.IsNaN(first.First)).Should(BeTrue())
+        })
+    })
+
+    Context("Navigation", func() {
+        It("Should find min, max, floor, ceiling, lower and higher", func() {
+            minEntry, _ := sortedMap.Min()
+            maxEntry, _ := sortedMap.Max()
+            Expect(minEntry.First).Should(Equal(10))
+            Expect(maxEntry.First).Should(Equal(90))
+
+            floor, _ := sortedMap.Floor(55)
+            Expect(floor.First).Should(Equal(50))
+            floor, _ = sortedMap.Floor(50)
+            Expect(floor.First).Should(Equal(50))
+            lower, _ := sortedMap.Lower(50)
+            Expect(lower.First).Should(Equal(30))
+            ceiling, _ := sortedMap.Ceiling(55)
+            Expect(ceiling.First).Should(Equal(70))
+            higher, _ := sortedMap.Higher(70)
+            Expect(higher.First).Should(Equal(80))
+
+            _, found := sortedMap.Lower(10)
+            Expect(found).Should(BeFalse())
+            _, found = sortedMap.Higher(90)
+            Expect(found).Should(BeFalse())
+            _, found = NewSortedMap[int, int]().Min()
+            Expect(found).Should(BeFalse())
+        })
+    })
+
+    Context("Range()", func() {
+        It("Should iterate over the half open range", func() {
+            keys := []int{}
+            for key := range sortedMap.Range(20, 80) {
+                keys = append(keys, key)
+            }
+            Expect(keys).Should(Equal([]int{20, 30, 50, 70}))
+
+            keys = []int{}
+            for key := range sortedMap.Range(25, 100) {
+                if key > 70 { break }
+                keys = append(keys, key)
+            }
+            Expect(keys).Should(Equal([]int{30, 50, 70}))
+        })
+    })
+
+    Context("Rank() and Select()", func() {
+        It("Should convert between keys and ranks", func() {
+            Expect(sortedMap.Rank(10)).Should(Equal(0))
+            Expect(sortedMap.Rank(55)).Should(Equal(4))
+            Expect(sortedMap.Rank(100)).Should(Equal(7))
+            entry, found := sortedMap.Select(3)
+            Expect(entry.First).Should(Equal(50))
+            Expect(found).Should(BeTrue())
+            _, found = sortedMap.Select(7)
+            Expect(found).Should(BeFalse())
+        })
+    })
+
+    Context("NewSortedMapFunc()", func() {
+        It("Should order keys with the comparer", func() {
+            byLength := NewSortedMapFunc[string, int](func(a, b string) int { return len(a) - len(b) })
+            byLength.Put("ccc", 3)
+            byLength.Put("a", 1)
+            byLength.Put("bb", 2)
+            Expect(byLength.Entries()).Should(Equal([]*Pair[string, int]{{"a", 1}, {"bb", 2}, {"ccc", 3}}))
+            Expect(byLength.Values()).Should(Equal([]int{1, 2, 3}))
+        })
+    })
+})
@@ -0,0 +1,139 @@
+package gollections
+
+import (
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Returns a sorted copy of the slice in ascending order
+func Sorted[T constraints.Ordered] (slice []T) []T {
+    sorted := append([]T{}, slice...)
+    Sort(sorted)
+    return sorted
+}
+
+// Returns a sorted copy of the slice in descending order
+func SortedDescending[T constraints.Ordered] (slice []T) []T {
+    sorted := append([]T{}, slice...)
+    SortDescending(sorted)
+    return sorted
+}
+
+// Returns a copy of the slice sorted by the key of each element in ascending order
+func SortedBy[T any, K constraints.Ordered] (slice []T, key func(T) K) []T {
+    if key == nil { return []T{} }
+    sorted := append([]T{}, slice...)
+    SortBy(sorted, key)
+    return sorted
+}
+
+// Returns a copy of the slice sorted by the key of each element in descending order
+func SortedByDescending[T any, K constraints.Ordered] (slice []T, key func(T) K) []T {
+    if key == nil { return []T{} }
+    sorted := append([]T{}, slice...)
+    SortByDescending(sorted, key)
+    return sorted
+}
+
+// Returns a copy of the slice sorted using the comparer function.
+// If a > b then comparer(a, b) > 0
+func SortedWith[T any] (slice []T, comparer func(T, T) int) []T {
+    if comparer == nil { return []T{} }
+    sorted := append([]T{}, slice...)
+    SortWith(sorted, comparer)
+    return sorted
+}
+
+// Returns a copy of the slice sorted by the key of each element in ascending order.
+// Elements with equal keys keep their original order.
+func SortedStableBy[T any, K constraints.Ordered] (slice []T, key func(T) K) []T {
+    if key == nil { return []T{} }
+    sorted := append([]T{}, slice...)
+    SortStableBy(sorted, key)
+    return sorted
+}
+
+// Returns a copy of the slice sorted by the key of each element in descending order.
+// Elements with equal keys keep their original order.
+func SortedStableByDescending[T any, K constraints.Ordered] (slice []T, key func(T) K) []T {
+    if key == nil { return []T{} }
+    sorted := append([]T{}, slice...)
+    SortStableByDescending(sorted, key)
+    return sorted
+}
+
+// Returns a copy of the slice sorted using the comparer function.
+// Elements comparing equal keep their original order.
+// If a > b then comparer(a, b) > 0
+func SortedStableWith[T any] (slice []T, comparer func(T, T) int) []T {
+    if comparer == nil { return []T{} }
+    sorted := append([]T{}, slice...)
+    SortStableWith(sorted, comparer)
+    return sorted
+}
+
+// Sorts the slice in place in ascending order
+func Sort[T constraints.Ordered] (slice []T) {
+    slices.Sort(slice)
+}
+
+// Sorts the slice in place in descending order
+func SortDescending[T constraints.Ordered] (slice []T) {
+    slices.SortFunc(slice, Reverse(ComparingOrdered[T]))
+}
+
+// Sorts the slice in place by the key of each element in ascending order
+func SortBy[T any, K constraints.Ordered] (slice []T, key func(T) K) {
+    if key == nil { return }
+    slices.SortFunc(slice, Comparing(key))
+}
+
+// Sorts the slice in place by the key of each element in descending order
+func SortByDescending[T any, K constraints.Ordered] (slice []T, key func(T) K) {
+    if key == nil { return }
+    slices.SortFunc(slice, Reverse(Comparing(key)))
+}
+
+// Sorts the slice in place using the comparer function.
+// If a > b then comparer(a, b) > 0
+func SortWith[T any] (slice []T, comparer func(T, T) int) {
+    if comparer == nil { return }
+    slices.SortFunc(slice, comparer)
+}
+
+// Sorts the slice in place by the key of each element in ascending order.
+// Elements with equal keys keep their original order.
+func SortStableBy[T any, K constraints.Ordered] (slice []T, key func(T) K) {
+    if key == nil { return }
+    slices.SortStableFunc(slice, Comparing(key))
+}
+
+// Sorts the slice in place by the key of each element in descending order.
+// Elements with equal keys keep their original order.
+func SortStableByDescending[T any, K constraints.Ordered] (slice []T, key func(T) K) {
+    if key == nil { return }
+    slices.SortStableFunc(slice, Reverse(Comparing(key)))
+}
+
+// Sorts the slice in place using the comparer function.
+// Elements comparing equal keep their original order.
+// If a > b then comparer(a, b) > 0
+func SortStableWith[T any] (slice []T, comparer func(T, T) int) {
+    if comparer == nil { return }
+    slices.SortStableFunc(slice, comparer)
+}
+
+// Returns a comparer ordering by the given comparer first,
+// and by the key in ascending order when it finds elements equal.
+// A nil comparer considers all elements equal, so ThenBy(nil, key) starts a chain.
+func ThenBy[T any, K constraints.Ordered] (comparer func(T, T) int, key func(T) K) func(T, T) int {
+    return ThenComparing(comparer, Comparing(key))
+}
+
+// Returns a comparer ordering by the given comparer first,
+// and by the key in descending order when it finds elements equal.
+// A nil comparer considers all elements equal, so ThenByDescending(nil, key) starts a chain.
+func ThenByDescending[T any, K constraints.Ordered] (comparer func(T, T) int, key func(T) K) func(T, T) int {
+    return ThenComparing(comparer, Reverse(Comparing(key)))
+}
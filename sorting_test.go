@@ -0,0 +1,67 @@
+package gollections_test
+
+import (
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for sorting", func() {
+
+    type person struct {
+        name string
+        age int
+    }
+    people := []person{{"carol", 30}, {"alice", 25}, {"bob", 30}, {"dave", 25}}
+    age := func(p person) int { return p.age }
+    name := func(p person) string { return p.name }
+
+    Context("Sorted functions", func() {
+        It("Should return sorted copies without touching the slice", func() {
+            slice := []int{3, 1, 2}
+            Expect(Sorted(slice)).Should(Equal([]int{1, 2, 3}))
+            Expect(SortedDescending(slice)).Should(Equal([]int{3, 2, 1}))
+            Expect(SortedWith(slice, func(a, b int) int { return b - a })).Should(Equal([]int{3, 2, 1}))
+            Expect(slice).Should(Equal([]int{3, 1, 2}))
+            Expect(Sorted([]int{})).Should(Equal([]int{}))
+            Expect(SortedBy[int, int](slice, nil)).Should(Equal([]int{}))
+        })
+
+        It("Should keep equal keys in order for stable variants", func() {
+            Expect(Map(SortedStableBy(people, age), name)).Should(Equal([]string{"alice", "dave", "carol", "bob"}))
+            Expect(Map(SortedStableByDescending(people, age), name)).Should(Equal([]string{"carol", "bob", "alice", "dave"}))
+            Expect(Map(SortedStableWith(people, func(a, b person) int { return a.age - b.age }), name)).
+                Should(Equal([]string{"alice", "dave", "carol", "bob"}))
+            Expect(Map(SortedBy(people, name), name)).Should(Equal([]string{"alice", "bob", "carol", "dave"}))
+            Expect(Map(SortedByDescending(people, name), name)).Should(Equal([]string{"dave", "carol", "bob", "alice"}))
+        })
+    })
+
+    Context("ThenBy()", func() {
+        It("Should order by multiple keys", func() {
+            byAgeThenName := ThenBy(ThenByDescending(nil, age), name)
+            Expect(Map(SortedWith(people, byAgeThenName), name)).Should(Equal([]string{"bob", "carol", "alice", "dave"}))
+        })
+    })
+
+    Context("In place functions", func() {
+        It("Should sort the slice itself", func() {
+            slice := []int{3, 1, 2}
+            Sort(slice)
+            Expect(slice).Should(Equal([]int{1, 2, 3}))
+            SortDescending(slice)
+            Expect(slice).Should(Equal([]int{3, 2, 1}))
+            SortBy(slice, func(elem int) int { return elem % 3 })
+            Expect(slice).Should(Equal([]int{3, 1, 2}))
+            SortWith(slice, func(a, b int) int { return a - b })
+            Expect(slice).Should(Equal([]int{1, 2, 3}))
+            copied := append([]person{}, people...)
+            SortStableBy(copied, age)
+            Expect(Map(copied, name)).Should(Equal([]string{"alice", "dave", "carol", "bob"}))
+            SortStableByDescending(copied, age)
+            Expect(Map(copied, name)).Should(Equal([]string{"carol", "bob", "alice", "dave"}))
+            SortByDescending(copied, name)
+            Expect(Map(copied, name)).Should(Equal([]string{"dave", "carol", "bob", "alice"}))
+            SortStableWith(copied, func(a, b person) int { return a.age - b.age })
+            Expect(Map(copied, name)).Should(Equal([]string{"dave", "alice", "carol", "bob"}))
+        })
+    })
+})
@@ -0,0 +1,80 @@
+package gollections
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Error returned when dependencies form a cycle.
+// Cycle starts and ends with the same node, each node depending on the next.
+type CycleError[T any] struct {
+    Cycle []T
+}
+
+func (e *CycleError[T]) Error() string {
+    return "dependency cycle: " + strings.Join(Map(e.Cycle, func(node T) string { return fmt.Sprint(node) }), " -> ")
+}
+
+// Orders the nodes so every node comes after its dependencies.
+// Dependencies missing from nodes are included as well.
+// The order is deterministic: nodes keep their given order unless a dependency has to be moved before them.
+// A nil deps means no node has dependencies.
+// Raises *CycleError with the offending path if the dependencies form a cycle.
+func TopoSort[T comparable] (nodes []T, deps func(T) []T) ([]T, error) {
+    if deps == nil {
+        deps = func(T) []T { return nil }
+    }
+    const (
+        visiting = iota + 1
+        visited
+    )
+    sorted := []T{}
+    state := map[T]int{}
+    path := []T{}
+    var visit func(T) error
+    visit = func(node T) error {
+        switch state[node] {
+        case visited:
+            return nil
+        case visiting:
+            start := IndexOf(path, node)
+            return &CycleError[T]{append(append([]T{}, path[start:]...), node)}
+        }
+        state[node] = visiting
+        path = append(path, node)
+        for _, dep := range deps(node) {
+            if err := visit(dep); err != nil { return err }
+        }
+        path = path[:len(path) - 1]
+        state[node] = visited
+        sorted = append(sorted, node)
+        return nil
+    }
+    for _, node := range nodes {
+        if err := visit(node); err != nil { return nil, err }
+    }
+    return sorted, nil
+}
+
+// Groups the nodes into levels so every node only depends on nodes of earlier levels.
+// Nodes of the same level are independent and can be processed in parallel.
+// Within a level nodes keep the order given by TopoSort.
+// Raises *CycleError with the offending path if the dependencies form a cycle.
+func TopoLevels[T comparable] (nodes []T, deps func(T) []T) ([][]T, error) {
+    sorted, err := TopoSort(nodes, deps)
+    if err != nil { return nil, err }
+    levels := [][]T{}
+    levelOf := map[T]int{}
+    for _, node := range sorted {
+        level := 0
+        if deps != nil {
+            level = Fold(deps(node), 0, func(dep T, acc int) int { return max(acc, levelOf[dep] + 1) })
+        }
+        levelOf[node] = level
+        if level == len(levels) {
+            levels = append(levels, []T{})
+        }
+        levels[level] = append(levels[level], node)
+    }
+    return levels, nil
+}
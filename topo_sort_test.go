@@ -0,0 +1,53 @@
+package gollections_test
+
+import (
+	"errors"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for topological sort", func() {
+
+    graph := map[string][]string{
+        "app": {"lib", "log"},
+        "lib": {"core"},
+        "log": {"core"},
+        "test": {"app"},
+    }
+    deps := func(node string) []string { return graph[node] }
+
+    Context("TopoSort()", func() {
+        It("Should put dependencies first in a stable order", func() {
+            sorted, err := TopoSort([]string{"test", "app", "core"}, deps)
+            Expect(err).Should(BeNil())
+            Expect(sorted).Should(Equal([]string{"core", "lib", "log", "app", "test"}))
+            sorted, _ = TopoSort([]string{"b", "a", "c"}, nil)
+            Expect(sorted).Should(Equal([]string{"b", "a", "c"}))
+            sorted, _ = TopoSort([]string{}, deps)
+            Expect(sorted).Should(Equal([]string{}))
+        })
+
+        It("Should report the cycle path", func() {
+            cyclic := map[int][]int{1: {2}, 2: {3}, 3: {4}, 4: {2}}
+            _, err := TopoSort([]int{1}, func(node int) []int { return cyclic[node] })
+            var cycleErr *CycleError[int]
+            Expect(errors.As(err, &cycleErr)).Should(BeTrue())
+            Expect(cycleErr.Cycle).Should(Equal([]int{2, 3, 4, 2}))
+            Expect(err.Error()).Should(Equal("dependency cycle: 2 -> 3 -> 4 -> 2"))
+            _, err = TopoSort([]int{7}, func(node int) []int { return []int{node} })
+            Expect(err.Error()).Should(Equal("dependency cycle: 7 -> 7"))
+        })
+    })
+
+    Context("TopoLevels()", func() {
+        It("Should group independent nodes into levels", func() {
+            levels, err := TopoLevels([]string{"test"}, deps)
+            Expect(err).Should(BeNil())
+            Expect(levels).Should(Equal([][]string{{"core"}, {"lib", "log"}, {"app"}, {"test"}}))
+            levels, _ = TopoLevels([]string{"x", "core", "y"}, nil)
+            Expect(levels).Should(Equal([][]string{{"x", "core", "y"}}))
+            _, err = TopoLevels([]string{"a"}, func(string) []string { return []string{"a"} })
+            Expect(err).ShouldNot(BeNil())
+        })
+    })
+})
@@ -0,0 +1,60 @@
+package gollections
+
+// Compressed prefix tree keyed by strings, for prefix lookups and autocomplete.
+// Keys are compared byte by byte, so sorted order is the order of Go strings.
+// The zero value is an empty trie ready to use.
+type Trie[V any] struct {
+    tree RadixTree[byte, V]
+}
+
+// Returns an empty trie
+func NewTrie[V any] () *Trie[V] {
+    return &Trie[V]{}
+}
+
+// Returns the number of keys in the trie
+func (t *Trie[V]) Len() int {
+    return t.tree.Len()
+}
+
+// Sets the value of the key
+func (t *Trie[V]) Insert(key string, value V) {
+    t.tree.Insert([]byte(key), value)
+}
+
+// Returns the value of the key and whether the key is there
+func (t *Trie[V]) Get(key string) (V, bool) {
+    return t.tree.Get([]byte(key))
+}
+
+// Deletes the key, returns whether it was there
+func (t *Trie[V]) Delete(key string) bool {
+    return t.tree.Delete([]byte(key))
+}
+
+// Returns the longest key in the trie that is a prefix of the given key,
+// along with its value. Returns false if there is no such key.
+func (t *Trie[V]) LongestPrefixMatch(key string) (string, V, bool) {
+    match, value, found := t.tree.LongestPrefixMatch([]byte(key))
+    return string(match), value, found
+}
+
+// Runs operation on every key starting with prefix in sorted order,
+// stopping early once operation returns false
+func (t *Trie[V]) WalkPrefix(prefix string, operation func(string, V) bool) {
+    if operation == nil { return }
+    t.tree.WalkPrefix([]byte(prefix), func(key []byte, value V) bool {
+        return operation(string(key), value)
+    })
+}
+
+// Returns every key starting with prefix in sorted order
+func (t *Trie[V]) KeysWithPrefix(prefix string) []string {
+    return t.Autocomplete(prefix, -1)
+}
+
+// Returns at most limit keys starting with prefix in sorted order.
+// A negative limit returns all of them.
+func (t *Trie[V]) Autocomplete(prefix string, limit int) []string {
+    return Map(t.tree.Autocomplete([]byte(prefix), limit), func(key []byte) string { return string(key) })
+}
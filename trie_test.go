@@ -0,0 +1,76 @@
+package gollections_test
+
+import (
+	"sort"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for trie", func() {
+
+    var trie *Trie[int]
+    var words []string
+
+    BeforeEach(func() {
+        trie = NewTrie[int]()
+        words = []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rom"}
+        ForEachIndexed(words, func(i int, word string) { trie.Insert(word, i) })
+    })
+
+    Context("Insert(), Get() and Delete()", func() {
+        It("Should store and remove keys", func() {
+            Expect(trie.Len()).Should(Equal(len(words)))
+            for i, word := range words {
+                value, found := trie.Get(word)
+                Expect(value).Should(Equal(i))
+                Expect(found).Should(BeTrue())
+            }
+            _, found := trie.Get("roman")
+            Expect(found).Should(BeFalse())
+
+            trie.Insert("rom", 100)
+            Expect(trie.Len()).Should(Equal(len(words)))
+            for _, word := range words {
+                Expect(trie.Delete(word)).Should(BeTrue())
+            }
+            Expect(trie.Len()).Should(Equal(0))
+            Expect(trie.KeysWithPrefix("")).Should(Equal([]string{}))
+        })
+    })
+
+    Context("LongestPrefixMatch()", func() {
+        It("Should find the longest stored prefix", func() {
+            trie.Insert("/", 0)
+            trie.Insert("/api", 1)
+            trie.Insert("/api/users", 2)
+            key, value, found := trie.LongestPrefixMatch("/api/users/42")
+            Expect(key).Should(Equal("/api/users"))
+            Expect(value).Should(Equal(2))
+            Expect(found).Should(BeTrue())
+            key, _, _ = trie.LongestPrefixMatch("/apix")
+            Expect(key).Should(Equal("/api"))
+            _, _, found = trie.LongestPrefixMatch("x")
+            Expect(found).Should(BeFalse())
+        })
+    })
+
+    Context("Prefix queries", func() {
+        It("Should return keys with a prefix in sorted order", func() {
+            sorted := append([]string{}, words...)
+            sort.Strings(sorted)
+            Expect(trie.KeysWithPrefix("")).Should(Equal(sorted))
+            Expect(trie.KeysWithPrefix("rub")).Should(Equal([]string{"rubens", "ruber", "rubicon", "rubicundus"}))
+            Expect(trie.KeysWithPrefix("roma")).Should(Equal([]string{"romane", "romanus"}))
+            Expect(trie.KeysWithPrefix("rx")).Should(Equal([]string{}))
+            Expect(trie.Autocomplete("r", 3)).Should(Equal([]string{"rom", "romane", "romanus"}))
+            Expect(trie.Autocomplete("r", 0)).Should(Equal([]string{}))
+
+            visited := []string{}
+            trie.WalkPrefix("rubic", func(key string, value int) bool {
+                visited = append(visited, key)
+                return false
+            })
+            Expect(visited).Should(Equal([]string{"rubicon"}))
+        })
+    })
+})
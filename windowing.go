@@ -0,0 +1,79 @@
+package gollections
+
+// Splits the slice into chunks of the given size.
+// The last chunk is smaller if the slice does not divide evenly.
+// Returns an empty slice if size is not positive.
+func Chunked[T any] (slice []T, size int) [][]T {
+    return ChunkedSeq(Lazy(slice), size).Collect()
+}
+
+// Returns windows of the given size sliding over the slice, starting every step elements.
+// If partial is true, the windows smaller than size at the end are included as well.
+// Windows are copies, so changing them does not change the slice.
+// Returns an empty slice if size or step is not positive.
+func Windowed[T any] (slice []T, size, step int, partial bool) [][]T {
+    return WindowedSeq(Lazy(slice), size, step, partial).Collect()
+}
+
+// Returns the transformed values of windows of the given size sliding over the slice,
+// starting every step elements. See Windowed for size, step and partial.
+func WindowedMap[T any, R any] (slice []T, size, step int, partial bool, transform func([]T) R) []R {
+    return MapSeq(WindowedSeq(Lazy(slice), size, step, partial), transform).Collect()
+}
+
+// Splits the slice between every two adjacent elements for which boundary returns true.
+// Returns an empty slice if boundary is nil.
+func ChunkedBy[T any] (slice []T, boundary func(T, T) bool) [][]T {
+    return ChunkedBySeq(Lazy(slice), boundary).Collect()
+}
+
+// Returns a sequence of chunks of the given size, see Chunked
+func ChunkedSeq[T any] (seq Seq[T], size int) Seq[[]T] {
+    return WindowedSeq(seq, size, size, true)
+}
+
+// Returns a sequence of windows sliding over the given sequence, see Windowed.
+// Only the elements of the current windows are kept in memory.
+func WindowedSeq[T any] (seq Seq[T], size, step int, partial bool) Seq[[]T] {
+    return func(yield func([]T) bool) {
+        if seq == nil || size <= 0 || step <= 0 { return }
+        window := make([]T, 0, size)
+        skip := 0
+        for elem := range seq {
+            if skip > 0 {
+                skip--
+                continue
+            }
+            window = append(window, elem)
+            if len(window) < size { continue }
+            if !yield(append([]T{}, window...)) { return }
+            if step < size {
+                window = append(window[:0], window[step:]...)
+            } else {
+                window, skip = window[:0], step - size
+            }
+        }
+        for partial && len(window) > 0 {
+            if !yield(append([]T{}, window...)) { return }
+            window = window[min(step, len(window)):]
+        }
+    }
+}
+
+// Returns a sequence of chunks split between adjacent elements for which boundary returns true, see ChunkedBy
+func ChunkedBySeq[T any] (seq Seq[T], boundary func(T, T) bool) Seq[[]T] {
+    return func(yield func([]T) bool) {
+        if seq == nil || boundary == nil { return }
+        var chunk []T
+        for elem := range seq {
+            if len(chunk) > 0 && boundary(chunk[len(chunk) - 1], elem) {
+                if !yield(chunk) { return }
+                chunk = nil
+            }
+            chunk = append(chunk, elem)
+        }
+        if len(chunk) > 0 {
+            yield(chunk)
+        }
+    }
+}
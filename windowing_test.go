@@ -0,0 +1,72 @@
+package gollections_test
+
+import (
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for windowing", func() {
+
+    list := []int{1, 2, 3, 4, 5, 6, 7}
+
+    Context("Chunked()", func() {
+        It("Should split the slice into chunks", func() {
+            Expect(Chunked(list, 3)).Should(Equal([][]int{{1, 2, 3}, {4, 5, 6}, {7}}))
+            Expect(Chunked(list, 7)).Should(Equal([][]int{list}))
+            Expect(Chunked([]int{}, 3)).Should(Equal([][]int{}))
+            Expect(Chunked(list, 0)).Should(Equal([][]int{}))
+        })
+    })
+
+    Context("Windowed()", func() {
+        It("Should slide windows with a step", func() {
+            Expect(Windowed(list, 3, 1, false)).Should(Equal([][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5, 6}, {5, 6, 7}}))
+            Expect(Windowed(list, 3, 2, false)).Should(Equal([][]int{{1, 2, 3}, {3, 4, 5}, {5, 6, 7}}))
+            Expect(Windowed(list, 2, 3, false)).Should(Equal([][]int{{1, 2}, {4, 5}}))
+            Expect(Windowed(list, 8, 1, false)).Should(Equal([][]int{}))
+            Expect(Windowed(list, 3, -1, true)).Should(Equal([][]int{}))
+        })
+
+        It("Should include partial windows at the end", func() {
+            Expect(Windowed(list, 3, 2, true)).Should(Equal([][]int{{1, 2, 3}, {3, 4, 5}, {5, 6, 7}, {7}}))
+            Expect(Windowed(list, 4, 3, true)).Should(Equal([][]int{{1, 2, 3, 4}, {4, 5, 6, 7}, {7}}))
+            Expect(Windowed(list, 2, 3, true)).Should(Equal([][]int{{1, 2}, {4, 5}, {7}}))
+            Expect(Windowed([]int{1, 2}, 5, 1, true)).Should(Equal([][]int{{1, 2}, {2}}))
+        })
+
+        It("Should return copies of the slice", func() {
+            windows := Windowed(list, 2, 1, false)
+            windows[0][0] = 100
+            Expect(list[0]).Should(Equal(1))
+            Expect(windows[1]).Should(Equal([]int{2, 3}))
+        })
+    })
+
+    Context("WindowedMap()", func() {
+        It("Should transform each window", func() {
+            sum := func(window []int) int { return Fold(window, 0, func(elem, acc int) int { return acc + elem }) }
+            Expect(WindowedMap(list, 3, 1, false, sum)).Should(Equal([]int{6, 9, 12, 15, 18}))
+            Expect(WindowedMap[int, int](list, 3, 1, false, nil)).Should(Equal([]int{}))
+        })
+    })
+
+    Context("ChunkedBy()", func() {
+        It("Should split where the boundary holds", func() {
+            notConsecutive := func(a, b int) bool { return b != a + 1 }
+            Expect(ChunkedBy([]int{1, 2, 3, 5, 6, 9}, notConsecutive)).Should(Equal([][]int{{1, 2, 3}, {5, 6}, {9}}))
+            Expect(ChunkedBy([]int{}, notConsecutive)).Should(Equal([][]int{}))
+            Expect(ChunkedBy(list, nil)).Should(Equal([][]int{}))
+        })
+    })
+
+    Context("Lazy forms", func() {
+        It("Should window an unbounded sequence", func() {
+            naturals := Seq[int](func(yield func(int) bool) {
+                for i := 1; yield(i); i++ {}
+            })
+            Expect(ChunkedSeq(naturals, 2).Take(3).Collect()).Should(Equal([][]int{{1, 2}, {3, 4}, {5, 6}}))
+            Expect(WindowedSeq(naturals, 3, 1, false).Skip(10).Take(1).Collect()).Should(Equal([][]int{{11, 12, 13}}))
+            Expect(ChunkedBySeq(naturals, func(_, b int) bool { return b % 4 == 0 }).Take(2).Collect()).
+                Should(Equal([][]int{{1, 2, 3}, {4, 5, 6, 7}}))
+        })
+    })
+})
@@ -0,0 +1,71 @@
+package gollections
+
+// Holds three values
+type Triple[T, R, S any] struct {
+    First T
+    Second R
+    Third S
+}
+
+// Returns the transformed values of elements at the same index of both slices.
+// Stops at the end of the shorter slice.
+func ZipWith[T, R, V any] (a []T, b []R, transform func(T, R) V) []V {
+    zipped := []V{}
+    if transform == nil { return zipped }
+    for i := 0; i < min(len(a), len(b)); i++ {
+        zipped = append(zipped, transform(a[i], b[i]))
+    }
+    return zipped
+}
+
+// Returns a slice of pointers of Pair zipping given slices.
+// The shorter slice is padded with its default value to the length of the longer one.
+func ZipLongest[T, R any] (a []T, b []R, defaultA T, defaultB R) []*Pair[T, R] {
+    zipped := []*Pair[T, R]{}
+    for i := 0; i < max(len(a), len(b)); i++ {
+        pair := &Pair[T, R]{defaultA, defaultB}
+        if i < len(a) {
+            pair.First = a[i]
+        }
+        if i < len(b) {
+            pair.Second = b[i]
+        }
+        zipped = append(zipped, pair)
+    }
+    return zipped
+}
+
+// Returns a slice of pointers of Triple zipping given slices.
+// Stops at the end of the shortest slice.
+func Zip3[T, R, S any] (a []T, b []R, c []S) []*Triple[T, R, S] {
+    zipped := []*Triple[T, R, S]{}
+    for i := 0; i < min(len(a), min(len(b), len(c))); i++ {
+        zipped = append(zipped, &Triple[T, R, S]{a[i], b[i], c[i]})
+    }
+    return zipped
+}
+
+// Splits the pairs into a slice of first values and a slice of second values.
+// Nil pairs are skipped.
+func Unzip[T, R any] (pairs []*Pair[T, R]) ([]T, []R) {
+    firsts, seconds := []T{}, []R{}
+    for _, pair := range pairs {
+        if pair == nil { continue }
+        firsts = append(firsts, pair.First)
+        seconds = append(seconds, pair.Second)
+    }
+    return firsts, seconds
+}
+
+// Splits the triples into slices of first, second and third values.
+// Nil triples are skipped.
+func Unzip3[T, R, S any] (triples []*Triple[T, R, S]) ([]T, []R, []S) {
+    firsts, seconds, thirds := []T{}, []R{}, []S{}
+    for _, triple := range triples {
+        if triple == nil { continue }
+        firsts = append(firsts, triple.First)
+        seconds = append(seconds, triple.Second)
+        thirds = append(thirds, triple.Third)
+    }
+    return firsts, seconds, thirds
+}
@@ -0,0 +1,53 @@
+package gollections_test
+
+import (
+	"strconv"
+
+	. "github.com/ashis0013/gollections"
+)
+
+var _ = Describe("Tests for zip utilities", func() {
+
+    list := []int{1, 2, 3}
+
+    Context("ZipWith()", func() {
+        It("Should combine elements at the same index", func() {
+            Expect(ZipWith(list, []string{"a", "b"}, func(n int, s string) string { return s + strconv.Itoa(n) })).
+                Should(Equal([]string{"a1", "b2"}))
+            Expect(ZipWith(list, []int{}, func(a, b int) int { return a + b })).Should(Equal([]int{}))
+            Expect(ZipWith[int, int, int](list, list, nil)).Should(Equal([]int{}))
+        })
+    })
+
+    Context("ZipLongest()", func() {
+        It("Should pad the shorter slice with defaults", func() {
+            Expect(ZipLongest(list, []string{"a"}, 0, "-")).Should(Equal([]*Pair[int, string]{{1, "a"}, {2, "-"}, {3, "-"}}))
+            Expect(ZipLongest([]int{}, []string{"a", "b"}, -1, "")).Should(Equal([]*Pair[int, string]{{-1, "a"}, {-1, "b"}}))
+            Expect(ZipLongest([]int{}, []int{}, 0, 0)).Should(Equal([]*Pair[int, int]{}))
+        })
+    })
+
+    Context("Zip3()", func() {
+        It("Should zip three slices up to the shortest", func() {
+            Expect(Zip3(list, []string{"a", "b"}, []bool{true, false, true})).
+                Should(Equal([]*Triple[int, string, bool]{{1, "a", true}, {2, "b", false}}))
+        })
+    })
+
+    Context("Unzip() and Unzip3()", func() {
+        It("Should split pairs and triples back into slices", func() {
+            numbers, words := Unzip(Zip(list, []string{"a", "b", "c"}))
+            Expect(numbers).Should(Equal(list))
+            Expect(words).Should(Equal([]string{"a", "b", "c"}))
+            numbers, words = Unzip([]*Pair[int, string]{nil, {4, "d"}})
+            Expect(numbers).Should(Equal([]int{4}))
+            Expect(words).Should(Equal([]string{"d"}))
+            firsts, seconds, thirds := Unzip3(Zip3(list, list, []string{"x", "y", "z"}))
+            Expect(firsts).Should(Equal(list))
+            Expect(seconds).Should(Equal(list))
+            Expect(thirds).Should(Equal([]string{"x", "y", "z"}))
+            empty, _ := Unzip[int, int](nil)
+            Expect(empty).Should(Equal([]int{}))
+        })
+    })
+})